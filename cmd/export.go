@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/db"
+	exportrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/export_repo"
+	"github.com/h-raju-arch/movie_app_backend/internal/service"
+)
+
+const exportUsage = "usage: export [-since TIME] [-batch-size N] [FILE]   (default stdout)"
+
+// runExport writes the catalogue in DATABASE_URL as NDJSON.
+func runExport(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	sinceFlag := fs.String("since", "", "only movies updated at or after this RFC 3339 time or YYYY-MM-DD date")
+	batchSize := fs.Int("batch-size", service.DefaultExportBatchSize, "movies read per batch")
+	fs.Parse(args)
+	if fs.NArg() > 1 || *batchSize < 1 {
+		log.Fatal(exportUsage)
+	}
+
+	var since *time.Time
+	if *sinceFlag != "" {
+		t, err := service.ParseExportSince(*sinceFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+		since = &t
+	}
+
+	var out io.Writer = os.Stdout
+	if path := fs.Arg(0); path != "" && path != "-" {
+		f, err := os.Create(path)
+		if err != nil {
+			log.Fatal("Error creating export file: ", err)
+		}
+		defer f.Close()
+		out = f
+	}
+	buf := bufio.NewWriter(out)
+
+	database := db.Open()
+	defer database.Close()
+
+	svc := service.New_Export_Service(exportrepo.New_Export_Repo(database))
+	n, err := svc.Export(context.Background(), buf, since, *batchSize)
+	// flush first so the movies written before an error still reach out
+	flushErr := buf.Flush()
+	if err != nil {
+		log.Fatalf("export stopped after %d movies: %v", n, err)
+	}
+	if flushErr != nil {
+		log.Fatal("Error writing export: ", flushErr)
+	}
+	fmt.Fprintf(os.Stderr, "exported %d movies\n", n)
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/db"
+	importrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/import_repo"
+	languagerepo "github.com/h-raju-arch/movie_app_backend/internal/repo/language_repo"
+	"github.com/h-raju-arch/movie_app_backend/internal/service"
+)
+
+const importUsage = "usage: import [-batch-size N] FILE   (FILE may be - for stdin)"
+
+// runImport loads an NDJSON catalogue dump into DATABASE_URL.
+func runImport(args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	batchSize := fs.Int("batch-size", service.DefaultImportBatchSize, "movies committed per transaction")
+	fs.Parse(args)
+	if fs.NArg() != 1 || *batchSize < 1 {
+		log.Fatal(importUsage)
+	}
+
+	var in io.Reader = os.Stdin
+	if path := fs.Arg(0); path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			log.Fatal("Error opening import file: ", err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	database := db.Open()
+	defer database.Close()
+
+	svc := service.New_Import_Service(importrepo.New_Import_Repo(database), languagerepo.New_Language_Repo(database))
+	summary, err := svc.Import(context.Background(), in, *batchSize)
+	if summary.Inserted+summary.Updated > 0 {
+		invalidateCache()
+	}
+
+	for _, e := range summary.Errors {
+		fmt.Printf("skipped line %d %s: %s\n", e.Line, e.ExternalID, e.Message)
+	}
+	if hidden := summary.Skipped - len(summary.Errors); hidden > 0 {
+		fmt.Printf("... and %d more skipped lines\n", hidden)
+	}
+	fmt.Printf("inserted %d, updated %d, skipped %d\n", summary.Inserted, summary.Updated, summary.Skipped)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// invalidateCache evicts every response the servers cached in Redis, which
+// the import may have changed. In-process caches (CACHE=memory) belong to
+// the servers and can't be reached from here; they age out on their own.
+func invalidateCache() {
+	if os.Getenv("CACHE") != "redis" {
+		return
+	}
+	redis := redisBackend()
+	defer redis.Close()
+	if err := service.New_Cache_Invalidator(redis).InvalidateAll(context.Background()); err != nil {
+		log.Println("Error invalidating the response cache:", err)
+	}
+}
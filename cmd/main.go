@@ -1,22 +1,257 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"flag"
 	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
 
+	"github.com/gin-gonic/gin"
+	"github.com/h-raju-arch/movie_app_backend/internal/auth"
+	"github.com/h-raju-arch/movie_app_backend/internal/cache"
 	"github.com/h-raju-arch/movie_app_backend/internal/db"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	accountrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/account_repo"
+	companyrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/company_repo"
+	exportrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/export_repo"
+	genrerepo "github.com/h-raju-arch/movie_app_backend/internal/repo/genre_repo"
+	languagerepo "github.com/h-raju-arch/movie_app_backend/internal/repo/language_repo"
+	memoryrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/memory_repo"
 	movierepo "github.com/h-raju-arch/movie_app_backend/internal/repo/movie_repo"
+	personrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/person_repo"
+	ratingrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/rating_repo"
+	reviewrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/review_repo"
+	userrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/user_repo"
+	viewrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/view_repo"
 	"github.com/h-raju-arch/movie_app_backend/internal/service"
 	httptransport "github.com/h-raju-arch/movie_app_backend/internal/transport/http"
 )
 
 func main() {
-	database := db.Open()
-	defer database.Close()
-	repo := movierepo.New_Movie_Repo(database)
-	svc := service.New_Movie_Service(repo)
-	router := httptransport.NewRouter(svc)
+	store := flag.String("store", "postgres", "backing store for serve: postgres or memory")
+	flag.Parse()
 
-	if err := router.Run(":3000"); err != nil {
-		log.Fatal("Failed to start server:", err)
+	args := flag.Args()
+	if len(args) > 0 {
+		switch args[0] {
+		case "migrate":
+			runMigrate(args[1:])
+			return
+		case "import":
+			runImport(args[1:])
+			return
+		case "export":
+			runExport(args[1:])
+			return
+		case "serve":
+		default:
+			log.Fatalf("unknown command %q (expected serve, migrate, import or export)", args[0])
+		}
 	}
+	serve(*store)
+}
+
+func serve(store string) {
+	var (
+		repo         movierepo.MovieRepository
+		writer       movierepo.MovieWriter
+		personRepo   personrepo.PersonRepository
+		companyRepo  companyrepo.CompanyRepository
+		genreRepo    genrerepo.GenreRepository
+		languageRepo languagerepo.LanguageRepository
+		exportRepo   exportrepo.ExportRepository
+		userRepo     userrepo.UserRepository
+		ratingRepo   ratingrepo.RatingRepository
+		accountRepo  accountrepo.AccountRepository
+		reviewRepo   reviewrepo.ReviewRepository
+		viewRepo     viewrepo.ViewRepository
+	)
+	switch store {
+	case "postgres":
+		database := db.Open()
+		defer database.Close()
+		movies := movierepo.New_Movie_Repo(database)
+		repo, writer = movies, movies
+		personRepo = personrepo.New_Person_Repo(database)
+		companyRepo = companyrepo.New_Company_Repo(database)
+		genreRepo = genrerepo.New_Genre_Repo(database)
+		languageRepo = languagerepo.New_Language_Repo(database)
+		exportRepo = exportrepo.New_Export_Repo(database)
+		userRepo = userrepo.New_User_Repo(database)
+		ratingRepo = ratingrepo.New_Rating_Repo(database)
+		accountRepo = accountrepo.New_Account_Repo(database)
+		reviewRepo = reviewrepo.New_Review_Repo(database)
+		viewRepo = viewrepo.New_View_Repo(database)
+	case "memory":
+		// no database at all: serve the seed catalogue from memory
+		mem := memoryrepo.New_Memory_Repo(fixtures.Build(time.Now()))
+		repo, writer, personRepo, companyRepo, genreRepo, languageRepo, exportRepo, userRepo, ratingRepo, accountRepo, reviewRepo, viewRepo = mem, mem, mem, mem, mem, mem, mem, mem, mem, mem, mem, mem
+		log.Println("Using in-memory store with the sample catalogue")
+	default:
+		log.Fatalf("unknown store %q (expected postgres or memory)", store)
+	}
+
+	// views are batched and popularity refreshed in the background for as
+	// long as the server runs
+	trending := service.New_Trending_Service(viewRepo, service.DefaultTrendingConfig)
+	trendingCtx, stopTrending := context.WithCancel(context.Background())
+	trendingDone := make(chan struct{})
+	go func() {
+		trending.Run(trendingCtx)
+		close(trendingDone)
+	}()
+
+	var (
+		movies     service.Movie_Service  = service.New_Movie_Service(repo)
+		admin      service.Admin_Service  = service.New_Admin_Service(writer, repo, languageRepo)
+		ratings    service.Rating_Service = service.New_Rating_Service(ratingRepo)
+		reviews    service.Review_Service = service.New_Review_Service(reviewRepo, repo)
+		cacheStats service.Cache_Stats
+	)
+	// with a response cache, the writes that change cached responses evict
+	// them as soon as they succeed
+	if backend := cacheBackend(); backend != nil {
+		cached := service.New_Cached_Movie_Service(movies, backend, service.DefaultCacheTTLs)
+		movies, cacheStats = cached, cached
+		admin = service.New_Invalidating_Admin_Service(admin, cached)
+		ratings = service.New_Invalidating_Rating_Service(ratings, cached)
+		reviews = service.New_Invalidating_Review_Service(reviews, cached)
+		log.Printf("Caching movie responses in %s", os.Getenv("CACHE"))
+	}
+
+	identity, optionalIdentity := identities()
+	router := httptransport.NewRouter(httptransport.Services{
+		Movie:         movies,
+		Person:        service.New_Person_Service(personRepo),
+		Company:       service.New_Company_Service(companyRepo),
+		Genre:         service.New_Genre_Service(genreRepo),
+		Configuration: service.New_Configuration_Service(languageRepo),
+		Admin:         admin,
+		Export:        service.New_Export_Service(exportRepo),
+		Auth:          service.New_Auth_Service(userRepo, auth.NewTokens(jwtSecret(), auth.DefaultAccessTTL, auth.DefaultRefreshTTL)),
+		Rating:        ratings,
+		Account:       service.New_Account_Service(accountRepo, repo),
+		Review:        reviews,
+		Trending:      trending,
+		Cache:         cacheStats,
+	}, httptransport.Options{
+		AdminToken:       os.Getenv("ADMIN_TOKEN"),
+		Identity:         identity,
+		OptionalIdentity: optionalIdentity,
+		MaxAge:           maxAges(),
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	server := &http.Server{Addr: ":3000", Handler: router}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("Failed to start server:", err)
+		}
+	}()
+	<-ctx.Done()
+	stop()
+
+	// finish the requests in flight first, so the views they record are
+	// still written when the trending service flushes its queue
+	log.Println("Shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Println("Error shutting down server:", err)
+	}
+	stopTrending()
+	<-trendingDone
+}
+
+// shutdownTimeout is how long requests in flight get to finish on shutdown.
+const shutdownTimeout = 10 * time.Second
+
+// Sizes of the response cache's backends.
+const (
+	cacheEntries  = 10000 // in-process LRU
+	redisPoolSize = 16    // idle Redis connections
+)
+
+// cacheBackend is where movie responses are cached: in-process when CACHE
+// is memory, the server at REDIS_ADDR when it is redis, and nowhere (nil)
+// when it isn't set.
+func cacheBackend() cache.Backend {
+	switch kind := os.Getenv("CACHE"); kind {
+	case "":
+		return nil
+	case "memory":
+		return cache.NewMemory(cacheEntries)
+	case "redis":
+		return redisBackend()
+	default:
+		log.Fatalf("unknown CACHE %q (expected memory or redis)", kind)
+		return nil
+	}
+}
+
+func redisBackend() *cache.Redis {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		addr = "localhost:6379"
+	}
+	return cache.NewRedis(addr, redisPoolSize)
+}
+
+// maxAges are the Cache-Control max-ages of the movie, search and discover
+// routes: MOVIE_MAX_AGE, SEARCH_MAX_AGE and DISCOVER_MAX_AGE in seconds, or
+// the defaults for those not set. 0 makes clients revalidate every time.
+func maxAges() httptransport.MaxAges {
+	ages := httptransport.DefaultMaxAges
+	for name, age := range map[string]*time.Duration{
+		"MOVIE_MAX_AGE":    &ages.Movie,
+		"SEARCH_MAX_AGE":   &ages.Search,
+		"DISCOVER_MAX_AGE": &ages.Discover,
+	} {
+		v := os.Getenv(name)
+		if v == "" {
+			continue
+		}
+		seconds, err := strconv.Atoi(v)
+		if err != nil || seconds < 0 {
+			log.Fatalf("invalid %s %q (expected a number of seconds)", name, v)
+		}
+		*age = time.Duration(seconds) * time.Second
+	}
+	return ages
+}
+
+// jwtSecret is the key user tokens are signed with. Without JWT_SECRET a
+// random key is used, so sessions don't survive a restart.
+func jwtSecret() []byte {
+	if secret := os.Getenv("JWT_SECRET"); secret != "" {
+		return []byte(secret)
+	}
+	log.Println("JWT_SECRET not set: using a random key, sessions end when the server stops")
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		log.Fatal("Error generating JWT secret: ", err)
+	}
+	return secret
+}
+
+// identities returns the middleware that identifies users, and the variant
+// for routes anonymous users may call too. With USER_ID_HEADER set the user
+// id is trusted from that header, for running behind a gateway that
+// authenticates users itself; otherwise the router checks the API's own
+// access tokens.
+func identities() (gin.HandlerFunc, gin.HandlerFunc) {
+	header := os.Getenv("USER_ID_HEADER")
+	if header == "" {
+		return nil, nil
+	}
+	log.Printf("Identifying users by the %s header", header)
+	return httptransport.TrustedUserHeader(header), httptransport.OptionalTrustedUserHeader(header)
 }
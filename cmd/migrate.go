@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/db"
+	"github.com/h-raju-arch/movie_app_backend/internal/migrations"
+)
+
+const migrateUsage = "usage: migrate up [N] | down [N] | status | goto VERSION"
+
+// runMigrate applies the embedded schema migrations against DATABASE_URL.
+func runMigrate(args []string) {
+	if len(args) == 0 {
+		log.Fatal(migrateUsage)
+	}
+
+	database := db.Open()
+	defer database.Close()
+
+	m, err := db.NewMigrator(database, migrations.FS)
+	if err != nil {
+		log.Fatal("Error loading migrations: ", err)
+	}
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		n := optionalCount(args[1:])
+		applied, err := m.Up(ctx, n)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Applied %d migration(s)", applied)
+
+	case "down":
+		// rolling back the whole schema by accident is much worse than
+		// having to repeat the command, so down defaults to one step
+		n := optionalCount(args[1:])
+		if n == 0 {
+			n = 1
+		}
+		rolled, err := m.Down(ctx, n)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Rolled back %d migration(s)", rolled)
+
+	case "goto":
+		if len(args) != 2 {
+			log.Fatal(migrateUsage)
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			log.Fatalf("invalid version %q", args[1])
+		}
+		if err := m.Goto(ctx, version); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Schema at version %d", version)
+
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, s := range statuses {
+			state := "pending"
+			if s.Applied {
+				state = "applied"
+			}
+			fmt.Printf("%-8s %d_%s\n", state, s.Version, s.Name)
+		}
+
+	default:
+		log.Fatal(migrateUsage)
+	}
+}
+
+func optionalCount(args []string) int {
+	if len(args) == 0 {
+		return 0
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 1 {
+		log.Fatalf("invalid step count %q", args[0])
+	}
+	return n
+}
@@ -0,0 +1,20 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type userIDKey struct{}
+
+// WithUserID returns a copy of ctx carrying the authenticated user's id.
+func WithUserID(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, userIDKey{}, id)
+}
+
+// UserID returns the id WithUserID stored in ctx, if any.
+func UserID(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(userIDKey{}).(uuid.UUID)
+	return id, ok
+}
@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+var testSecret = []byte("test secret")
+
+func TestSignParse_RoundTrip(t *testing.T) {
+	now := time.Unix(1_800_000_000, 0)
+	claims := Claims{Subject: "user", Type: AccessToken, IssuedAt: now.Unix(), ExpiresAt: now.Add(time.Minute).Unix()}
+
+	token, err := Sign(testSecret, claims)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if strings.Count(token, ".") != 2 {
+		t.Fatalf("expected a compact JWT, got %s", token)
+	}
+
+	got, err := Parse(testSecret, token, now)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got != claims {
+		t.Errorf("expected %+v, got %+v", claims, got)
+	}
+}
+
+func TestParse_Rejects(t *testing.T) {
+	now := time.Unix(1_800_000_000, 0)
+	valid, _ := Sign(testSecret, Claims{Subject: "user", ExpiresAt: now.Add(time.Minute).Unix()})
+	parts := strings.Split(valid, ".")
+	none := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"admin","exp":9999999999}`))
+
+	tests := []struct {
+		name  string
+		token string
+		now   time.Time
+	}{
+		{"malformed", "not-a-token", now},
+		{"wrong secret", func() string { s, _ := Sign([]byte("other"), Claims{ExpiresAt: now.Add(time.Minute).Unix()}); return s }(), now},
+		{"tampered payload", parts[0] + "." + forged + "." + parts[2], now},
+		{"alg none", none + "." + parts[1] + ".", now},
+		{"expired", valid, now.Add(time.Minute)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(testSecret, tt.token, tt.now); !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("expected ErrInvalidToken, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTokens_Verify(t *testing.T) {
+	tokens := NewTokens(testSecret, time.Minute, time.Hour)
+	userID := uuid.Must(uuid.NewV7())
+
+	pair, err := tokens.Issue(userID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if pair.TokenType != "Bearer" || pair.ExpiresIn != 60 {
+		t.Errorf("unexpected pair %+v", pair)
+	}
+
+	got, err := tokens.Verify(pair.AccessToken, AccessToken)
+	if err != nil || got != userID {
+		t.Errorf("expected %s, got %s, %v", userID, got, err)
+	}
+	if _, err := tokens.Verify(pair.RefreshToken, AccessToken); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expected a refresh token to be refused as access token, got %v", err)
+	}
+
+	// the access token expires before the refresh token
+	tokens.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	if _, err := tokens.Verify(pair.AccessToken, AccessToken); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expected an expired access token, got %v", err)
+	}
+	if _, err := tokens.Verify(pair.RefreshToken, RefreshToken); err != nil {
+		t.Errorf("expected a valid refresh token, got %v", err)
+	}
+}
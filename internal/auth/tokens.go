@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+const (
+	AccessToken  = "access"
+	RefreshToken = "refresh"
+
+	DefaultAccessTTL  = 15 * time.Minute
+	DefaultRefreshTTL = 30 * 24 * time.Hour
+)
+
+// Tokens issues and verifies the access / refresh token pairs of users.
+// Tokens are stateless: nothing is stored, so a refresh token stays valid
+// until it expires.
+type Tokens struct {
+	secret     []byte
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	now        func() time.Time
+}
+
+func NewTokens(secret []byte, accessTTL, refreshTTL time.Duration) *Tokens {
+	return &Tokens{secret: secret, accessTTL: accessTTL, refreshTTL: refreshTTL, now: time.Now}
+}
+
+// Issue signs a new token pair for userID.
+func (t *Tokens) Issue(userID uuid.UUID) (model.TokenPair, error) {
+	now := t.now()
+	access, err := Sign(t.secret, Claims{Subject: userID.String(), Type: AccessToken, IssuedAt: now.Unix(), ExpiresAt: now.Add(t.accessTTL).Unix()})
+	if err != nil {
+		return model.TokenPair{}, err
+	}
+	refresh, err := Sign(t.secret, Claims{Subject: userID.String(), Type: RefreshToken, IssuedAt: now.Unix(), ExpiresAt: now.Add(t.refreshTTL).Unix()})
+	if err != nil {
+		return model.TokenPair{}, err
+	}
+	return model.TokenPair{
+		AccessToken:  access,
+		RefreshToken: refresh,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(t.accessTTL.Seconds()),
+	}, nil
+}
+
+// Verify checks that token is a valid token of the given type (AccessToken
+// or RefreshToken) and returns the user id it was issued for.
+func (t *Tokens) Verify(token, typ string) (uuid.UUID, error) {
+	claims, err := Parse(t.secret, token, t.now())
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if claims.Type != typ {
+		return uuid.Nil, fmt.Errorf("%w: %s token used as %s token", ErrInvalidToken, claims.Type, typ)
+	}
+	userID, err := uuid.FromString(claims.Subject)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: bad subject", ErrInvalidToken)
+	}
+	return userID, nil
+}
@@ -0,0 +1,22 @@
+// Package cache holds the key/value stores the service layer caches
+// responses in: an in-process LRU, and a client for Redis or anything else
+// speaking its protocol.
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Backend is a key/value store with expiring entries. Implementations are
+// safe for concurrent use.
+type Backend interface {
+	// Get returns the value stored under key and whether there is one.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+
+	// Set stores value under key for ttl; a ttl <= 0 keeps it until it is
+	// evicted or deleted.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+
+	Delete(ctx context.Context, keys ...string) error
+}
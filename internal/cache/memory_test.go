@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemory_LRU(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory(2)
+
+	m.Set(ctx, "a", []byte("1"), 0)
+	m.Set(ctx, "b", []byte("2"), 0)
+	m.Get(ctx, "a") // b is now the least recently used
+	m.Set(ctx, "c", []byte("3"), 0)
+
+	if _, ok, _ := m.Get(ctx, "b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok, _ := m.Get(ctx, key); !ok {
+			t.Errorf("expected %s to be kept", key)
+		}
+	}
+	if m.Len() != 2 {
+		t.Errorf("expected 2 entries, got %d", m.Len())
+	}
+
+	m.Set(ctx, "a", []byte("4"), 0)
+	if v, _, _ := m.Get(ctx, "a"); string(v) != "4" {
+		t.Errorf("expected a replaced, got %q", v)
+	}
+	m.Delete(ctx, "a", "missing")
+	if _, ok, _ := m.Get(ctx, "a"); ok || m.Len() != 1 {
+		t.Errorf("expected a deleted, %d entries left", m.Len())
+	}
+}
+
+func TestMemory_TTL(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	m := NewMemory(10)
+	m.now = func() time.Time { return now }
+
+	m.Set(ctx, "short", []byte("1"), time.Minute)
+	m.Set(ctx, "forever", []byte("2"), 0)
+
+	now = now.Add(59 * time.Second)
+	if _, ok, _ := m.Get(ctx, "short"); !ok {
+		t.Error("expected the entry before its ttl")
+	}
+	now = now.Add(time.Second)
+	if _, ok, _ := m.Get(ctx, "short"); ok {
+		t.Error("expected the entry to expire after its ttl")
+	}
+	if _, ok, _ := m.Get(ctx, "forever"); !ok || m.Len() != 1 {
+		t.Errorf("expected only the entry without ttl left, got %d", m.Len())
+	}
+}
@@ -0,0 +1,137 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+)
+
+// DefaultRedisTimeout bounds each command when ctx has no earlier deadline.
+const DefaultRedisTimeout = time.Second
+
+// Redis is a Backend on a Redis server, or anything else speaking RESP
+// (see ServeRESP). It keeps up to poolSize idle connections; bounding the
+// number of entries is left to the server's maxmemory policy.
+type Redis struct {
+	addr    string
+	timeout time.Duration
+	idle    chan *redisConn
+}
+
+type redisConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+	w    *bufio.Writer
+}
+
+func NewRedis(addr string, poolSize int) *Redis {
+	return &Redis{addr: addr, timeout: DefaultRedisTimeout, idle: make(chan *redisConn, poolSize)}
+}
+
+func (c *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	reply, err := c.do(ctx, "GET", []byte(key))
+	if err != nil {
+		return nil, false, err
+	}
+	b, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("redis GET: unexpected reply %v", reply)
+	}
+	return b, b != nil, nil
+}
+
+func (c *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	args := [][]byte{[]byte(key), value}
+	if ttl > 0 {
+		// Redis rejects PX 0, so a ttl under a millisecond keeps the entry for one
+		ms := max(ttl.Milliseconds(), 1)
+		args = append(args, []byte("PX"), []byte(strconv.FormatInt(ms, 10)))
+	}
+	reply, err := c.do(ctx, "SET", args...)
+	if err != nil {
+		return err
+	}
+	if reply != "OK" {
+		return fmt.Errorf("redis SET: unexpected reply %v", reply)
+	}
+	return nil
+}
+
+func (c *Redis) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	args := make([][]byte, len(keys))
+	for i, k := range keys {
+		args[i] = []byte(k)
+	}
+	_, err := c.do(ctx, "DEL", args...)
+	return err
+}
+
+// Close closes the idle connections.
+func (c *Redis) Close() error {
+	for {
+		select {
+		case rc := <-c.idle:
+			rc.conn.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+// do sends one command and reads its reply. Connections that fail are
+// closed rather than reused; error replies come back as RESPError.
+func (c *Redis) do(ctx context.Context, cmd string, args ...[]byte) (any, error) {
+	rc, err := c.conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("redis %s: %w", cmd, err)
+	}
+
+	deadline := time.Now().Add(c.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	rc.conn.SetDeadline(deadline)
+
+	reply, err := c.roundTrip(rc, append([][]byte{[]byte(cmd)}, args...))
+	if err != nil {
+		rc.conn.Close()
+		return nil, fmt.Errorf("redis %s: %w", cmd, err)
+	}
+
+	select {
+	case c.idle <- rc:
+	default:
+		rc.conn.Close()
+	}
+	if rerr, ok := reply.(RESPError); ok {
+		return nil, fmt.Errorf("redis %s: %w", cmd, rerr)
+	}
+	return reply, nil
+}
+
+func (c *Redis) roundTrip(rc *redisConn, args [][]byte) (any, error) {
+	if err := writeCommand(rc.w, args...); err != nil {
+		return nil, err
+	}
+	return readValue(rc.r)
+}
+
+func (c *Redis) conn(ctx context.Context) (*redisConn, error) {
+	select {
+	case rc := <-c.idle:
+		return rc, nil
+	default:
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return nil, err
+	}
+	return &redisConn{conn: conn, r: bufio.NewReader(conn), w: bufio.NewWriter(conn)}, nil
+}
@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+// newTestRedis returns a client of a ServeRESP stand-in over store.
+func newTestRedis(t *testing.T, store *Memory) *Redis {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	go ServeRESP(l, store)
+	c := NewRedis(l.Addr().String(), 2)
+	t.Cleanup(func() {
+		c.Close()
+		l.Close()
+	})
+	return c
+}
+
+func TestRedis_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	store := NewMemory(10)
+	store.now = func() time.Time { return now }
+	c := newTestRedis(t, store)
+
+	if _, ok, err := c.Get(ctx, "missing"); err != nil || ok {
+		t.Fatalf("expected a miss, got %v, %v", ok, err)
+	}
+	value := []byte("line one\r\nline two with $5 and *2")
+	if err := c.Set(ctx, "k", value, 1500*time.Millisecond); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := c.Set(ctx, "empty", []byte{}, 0); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := c.Set(ctx, "short", value, 500*time.Microsecond); err != nil {
+		t.Fatalf("expected a sub-millisecond ttl to be accepted, got %v", err)
+	}
+
+	if v, ok, err := c.Get(ctx, "k"); err != nil || !ok || string(v) != string(value) {
+		t.Errorf("expected the value back, got %q, %v, %v", v, ok, err)
+	}
+	if v, ok, _ := c.Get(ctx, "empty"); !ok || len(v) != 0 {
+		t.Errorf("expected an empty value, got %q, %v", v, ok)
+	}
+
+	// PX reaches the store as the entry's ttl
+	now = now.Add(1500 * time.Millisecond)
+	if _, ok, _ := c.Get(ctx, "k"); ok {
+		t.Error("expected the entry to expire")
+	}
+
+	if err := c.Delete(ctx, "empty", "missing"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, ok, _ := c.Get(ctx, "empty"); ok {
+		t.Error("expected the entry deleted")
+	}
+}
+
+func TestRedis_Errors(t *testing.T) {
+	ctx := context.Background()
+	c := newTestRedis(t, NewMemory(10))
+
+	_, err := c.do(ctx, "FLUSHALL")
+	var rerr RESPError
+	if !errors.As(err, &rerr) {
+		t.Fatalf("expected an error reply, got %v", err)
+	}
+	// the connection stays usable after an error reply
+	if reply, err := c.do(ctx, "PING"); err != nil || reply != "PONG" {
+		t.Errorf("expected PONG, got %v, %v", reply, err)
+	}
+
+	down := NewRedis("127.0.0.1:1", 1)
+	if _, _, err := down.Get(ctx, "k"); err == nil {
+		t.Error("expected an error without a server")
+	}
+}
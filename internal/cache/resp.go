@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// The subset of RESP (the Redis serialization protocol) the client and
+// ServeRESP need: commands are arrays of bulk strings; replies are simple
+// strings, errors, integers, bulk strings or arrays.
+
+// RESPError is an error reply.
+type RESPError string
+
+func (e RESPError) Error() string {
+	return string(e)
+}
+
+// maxBulkLen caps the bulk strings read, as Redis does.
+const maxBulkLen = 512 << 20
+
+// writeCommand writes args as an array of bulk strings.
+func writeCommand(w *bufio.Writer, args ...[]byte) error {
+	fmt.Fprintf(w, "*%d\r\n", len(args))
+	for _, a := range args {
+		writeBulk(w, a)
+	}
+	return w.Flush()
+}
+
+func writeBulk(w *bufio.Writer, b []byte) {
+	if b == nil {
+		w.WriteString("$-1\r\n")
+		return
+	}
+	fmt.Fprintf(w, "$%d\r\n", len(b))
+	w.Write(b)
+	w.WriteString("\r\n")
+}
+
+// readValue reads one value: a string for simple strings, RESPError,
+// int64, []byte (nil for the null bulk string) or []any.
+func readValue(r *bufio.Reader) (any, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 {
+		return nil, errors.New("resp: empty line")
+	}
+	body := string(line[1:])
+	switch line[0] {
+	case '+':
+		return body, nil
+	case '-':
+		return RESPError(body), nil
+	case ':':
+		n, err := strconv.ParseInt(body, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("resp: bad integer %q", body)
+		}
+		return n, nil
+	case '$':
+		n, err := strconv.Atoi(body)
+		if err != nil || n < -1 || n > maxBulkLen {
+			return nil, fmt.Errorf("resp: bad bulk length %q", body)
+		}
+		if n == -1 {
+			return []byte(nil), nil
+		}
+		b := make([]byte, n+2)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	case '*':
+		n, err := strconv.Atoi(body)
+		if err != nil || n < -1 {
+			return nil, fmt.Errorf("resp: bad array length %q", body)
+		}
+		if n == -1 {
+			return []any(nil), nil
+		}
+		values := make([]any, n)
+		for i := range values {
+			if values[i], err = readValue(r); err != nil {
+				return nil, err
+			}
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("resp: unknown type %q", line[0])
+}
+
+func readLine(r *bufio.Reader) ([]byte, error) {
+	line, err := r.ReadSlice('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 2 || line[len(line)-2] != '\r' {
+		return nil, errors.New("resp: line not terminated by CRLF")
+	}
+	return line[:len(line)-2], nil
+}
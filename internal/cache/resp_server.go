@@ -0,0 +1,113 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ServeRESP answers the Redis commands the Redis client uses (PING, GET,
+// SET with PX or EX, DEL) on connections accepted from l, storing the
+// entries in b. Over a Memory it stands in for a Redis server in tests and
+// local runs. It returns when l is closed.
+func ServeRESP(l net.Listener, b Backend) error {
+	for {
+		conn, err := l.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		go serveRESPConn(conn, b)
+	}
+}
+
+func serveRESPConn(conn net.Conn, b Backend) {
+	defer conn.Close()
+	r, w := bufio.NewReader(conn), bufio.NewWriter(conn)
+	for {
+		v, err := readValue(r)
+		if err != nil {
+			return
+		}
+		args, ok := v.([]any)
+		if !ok || len(args) == 0 {
+			w.WriteString("-ERR expected a command array\r\n")
+		} else {
+			respond(w, b, args)
+		}
+		if err := w.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func respond(w *bufio.Writer, b Backend, args []any) {
+	strs := make([]string, len(args))
+	for i, a := range args {
+		bs, ok := a.([]byte)
+		if !ok {
+			w.WriteString("-ERR expected bulk string arguments\r\n")
+			return
+		}
+		strs[i] = string(bs)
+	}
+
+	ctx := context.Background()
+	switch cmd := strings.ToUpper(strs[0]); {
+	case cmd == "PING":
+		w.WriteString("+PONG\r\n")
+
+	case cmd == "GET" && len(strs) == 2:
+		value, ok, err := b.Get(ctx, strs[1])
+		if err != nil {
+			fmt.Fprintf(w, "-ERR %v\r\n", err)
+			return
+		}
+		if !ok {
+			value = nil
+		} else if value == nil {
+			value = []byte{}
+		}
+		writeBulk(w, value)
+
+	case cmd == "SET" && (len(strs) == 3 || len(strs) == 5):
+		var ttl time.Duration
+		if len(strs) == 5 {
+			n, err := strconv.ParseInt(strs[4], 10, 64)
+			unit := map[string]time.Duration{"PX": time.Millisecond, "EX": time.Second}[strings.ToUpper(strs[3])]
+			if err != nil || n <= 0 || unit == 0 {
+				w.WriteString("-ERR syntax error\r\n")
+				return
+			}
+			ttl = time.Duration(n) * unit
+		}
+		if err := b.Set(ctx, strs[1], []byte(strs[2]), ttl); err != nil {
+			fmt.Fprintf(w, "-ERR %v\r\n", err)
+			return
+		}
+		w.WriteString("+OK\r\n")
+
+	case cmd == "DEL" && len(strs) >= 2:
+		deleted := 0
+		for _, key := range strs[1:] {
+			if _, ok, _ := b.Get(ctx, key); ok {
+				deleted++
+			}
+		}
+		if err := b.Delete(ctx, strs[1:]...); err != nil {
+			fmt.Fprintf(w, "-ERR %v\r\n", err)
+			return
+		}
+		fmt.Fprintf(w, ":%d\r\n", deleted)
+
+	default:
+		fmt.Fprintf(w, "-ERR unknown command or wrong number of arguments for '%s'\r\n", strs[0])
+	}
+}
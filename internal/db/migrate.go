@@ -0,0 +1,308 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// migrationLockID is the pg advisory lock key held while migrating so two
+// deploys can't apply the same version concurrently.
+const migrationLockID = 72707369
+
+// schema_migrations uses the same single-row layout as golang-migrate, so
+// databases migrated with the CLI are picked up without any conversion.
+const createSchemaTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+  version BIGINT NOT NULL PRIMARY KEY,
+  dirty BOOLEAN NOT NULL
+)`
+
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type MigrationStatus struct {
+	Migration
+	Applied bool
+}
+
+// LoadMigrations reads <version>_<name>.up.sql / .down.sql pairs from the root
+// of fsys and returns them ordered by version.
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations dir: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, e := range entries {
+		if e.IsDir() || path.Ext(e.Name()) != ".sql" {
+			continue
+		}
+		file := e.Name()
+		base := strings.TrimSuffix(file, ".sql")
+		var direction string
+		switch {
+		case strings.HasSuffix(base, ".up"):
+			direction = "up"
+		case strings.HasSuffix(base, ".down"):
+			direction = "down"
+		default:
+			return nil, fmt.Errorf("migration %s: missing .up/.down suffix", file)
+		}
+		base = strings.TrimSuffix(base, "."+direction)
+
+		versionStr, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("migration %s: expected <version>_<name>", file)
+		}
+		version, err := strconv.ParseInt(versionStr, 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("migration %s: invalid version %q", file, versionStr)
+		}
+
+		body, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", file, err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		} else if m.Name != name {
+			return nil, fmt.Errorf("migration version %d used by both %q and %q", version, m.Name, name)
+		}
+
+		if direction == "up" {
+			m.Up = string(body)
+		} else {
+			m.Down = string(body)
+		}
+	}
+
+	res := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s: missing up file", m.Version, m.Name)
+		}
+		if m.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s: missing down file", m.Version, m.Name)
+		}
+		res = append(res, *m)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Version < res[j].Version })
+	return res, nil
+}
+
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+func NewMigrator(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := LoadMigrations(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Up applies pending migrations in order. n <= 0 applies all of them.
+// It returns the number of migrations applied.
+func (m *Migrator) Up(ctx context.Context, n int) (int, error) {
+	applied := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		var err error
+		applied, err = m.up(ctx, conn, n)
+		return err
+	})
+	return applied, err
+}
+
+// Down rolls back applied migrations, newest first. n <= 0 rolls back all
+// of them. It returns the number of migrations rolled back.
+func (m *Migrator) Down(ctx context.Context, n int) (int, error) {
+	rolled := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		var err error
+		rolled, err = m.down(ctx, conn, n)
+		return err
+	})
+	return rolled, err
+}
+
+// Goto migrates up or down until version is the latest applied migration.
+// Version 0 means an empty schema.
+func (m *Migrator) Goto(ctx context.Context, version int64) error {
+	known := version == 0
+	for _, mig := range m.migrations {
+		if mig.Version == version {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return fmt.Errorf("migrate goto: unknown version %d", version)
+	}
+
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		current, err := currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		steps := 0
+		for _, mig := range m.migrations {
+			if (mig.Version > current && mig.Version <= version) || (mig.Version > version && mig.Version <= current) {
+				steps++
+			}
+		}
+		switch {
+		case version > current:
+			_, err = m.up(ctx, conn, steps)
+		case version < current:
+			_, err = m.down(ctx, conn, steps)
+		}
+		return err
+	})
+}
+
+func (m *Migrator) up(ctx context.Context, conn *sql.Conn, n int) (int, error) {
+	current, err := currentVersion(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	applied := 0
+	for _, mig := range m.migrations {
+		if mig.Version <= current {
+			continue
+		}
+		if n > 0 && applied == n {
+			break
+		}
+		if err := apply(ctx, conn, mig.Up, mig.Version); err != nil {
+			return applied, fmt.Errorf("migrate up %d_%s: %w", mig.Version, mig.Name, err)
+		}
+		applied++
+	}
+	return applied, nil
+}
+
+func (m *Migrator) down(ctx context.Context, conn *sql.Conn, n int) (int, error) {
+	current, err := currentVersion(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	rolled := 0
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		mig := m.migrations[i]
+		if mig.Version > current {
+			continue
+		}
+		if n > 0 && rolled == n {
+			break
+		}
+		var prev int64
+		if i > 0 {
+			prev = m.migrations[i-1].Version
+		}
+		if err := apply(ctx, conn, mig.Down, prev); err != nil {
+			return rolled, fmt.Errorf("migrate down %d_%s: %w", mig.Version, mig.Name, err)
+		}
+		rolled++
+	}
+	return rolled, nil
+}
+
+// Version returns the latest applied migration version, 0 if none.
+func (m *Migrator) Version(ctx context.Context) (int64, error) {
+	var version int64
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		v, err := currentVersion(ctx, conn)
+		version = v
+		return err
+	})
+	return version, err
+}
+
+// Status lists every embedded migration and whether it has been applied.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	current, err := m.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]MigrationStatus, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		res = append(res, MigrationStatus{Migration: mig, Applied: mig.Version <= current})
+	}
+	return res, nil
+}
+
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate: get conn: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, migrationLockID); err != nil {
+		return fmt.Errorf("migrate: acquire lock: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, migrationLockID)
+
+	if _, err := conn.ExecContext(ctx, createSchemaTable); err != nil {
+		return fmt.Errorf("migrate: create schema_migrations: %w", err)
+	}
+	return fn(conn)
+}
+
+func currentVersion(ctx context.Context, conn *sql.Conn) (int64, error) {
+	var (
+		version int64
+		dirty   bool
+	)
+	err := conn.QueryRowContext(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("migrate: read schema_migrations: %w", err)
+	}
+	if dirty {
+		return 0, fmt.Errorf("migrate: database is dirty at version %d, fix it by hand and reset the dirty flag", version)
+	}
+	return version, nil
+}
+
+// apply runs one migration body and records the resulting version in the
+// same transaction, so a failed migration leaves the schema untouched.
+func apply(ctx context.Context, conn *sql.Conn, body string, version int64) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, body); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations`); err != nil {
+		return err
+	}
+	if version > 0 {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, dirty) VALUES ($1, false)`, version); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/migrations"
+)
+
+func TestLoadMigrations_Embedded(t *testing.T) {
+	migs, err := LoadMigrations(migrations.FS)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(migs) == 0 {
+		t.Fatal("expected embedded migrations, got none")
+	}
+	for i := 1; i < len(migs); i++ {
+		if migs[i-1].Version >= migs[i].Version {
+			t.Errorf("migrations not ordered: %d before %d", migs[i-1].Version, migs[i].Version)
+		}
+	}
+	if !strings.Contains(migs[0].Up, "CREATE TABLE movies") {
+		t.Errorf("expected first migration to create movies, got %q", migs[0].Name)
+	}
+}
+
+func TestLoadMigrations_Ordering(t *testing.T) {
+	fsys := fstest.MapFS{
+		"2_second.up.sql":   {Data: []byte("CREATE TABLE b ();")},
+		"2_second.down.sql": {Data: []byte("DROP TABLE b;")},
+		"1_first.up.sql":    {Data: []byte("CREATE TABLE a ();")},
+		"1_first.down.sql":  {Data: []byte("DROP TABLE a;")},
+		"README.md":         {Data: []byte("ignored")},
+	}
+
+	migs, err := LoadMigrations(fsys)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(migs) != 2 {
+		t.Fatalf("expected 2 migrations, got %d", len(migs))
+	}
+	if migs[0].Version != 1 || migs[0].Name != "first" {
+		t.Errorf("expected 1_first, got %d_%s", migs[0].Version, migs[0].Name)
+	}
+	if migs[1].Down != "DROP TABLE b;" {
+		t.Errorf("unexpected down body %q", migs[1].Down)
+	}
+}
+
+func TestLoadMigrations_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+	}{
+		{"missing down", fstest.MapFS{
+			"1_a.up.sql": {Data: []byte("SELECT 1;")},
+		}},
+		{"missing direction", fstest.MapFS{
+			"1_a.sql": {Data: []byte("SELECT 1;")},
+		}},
+		{"bad version", fstest.MapFS{
+			"abc_a.up.sql":   {Data: []byte("SELECT 1;")},
+			"abc_a.down.sql": {Data: []byte("SELECT 1;")},
+		}},
+		{"duplicate version", fstest.MapFS{
+			"1_a.up.sql":   {Data: []byte("SELECT 1;")},
+			"1_a.down.sql": {Data: []byte("SELECT 1;")},
+			"1_b.up.sql":   {Data: []byte("SELECT 1;")},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoadMigrations(tt.fsys); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
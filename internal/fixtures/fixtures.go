@@ -0,0 +1,322 @@
+// Package fixtures holds the sample catalogue shared by the database seed
+// and the in-memory repository, so both start from identical data.
+package fixtures
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// translationLanguages are the languages every HasTranslations movie gets a
+// (generated) translation for.
+var translationLanguages = []struct {
+	Lang           string
+	TitleSuffix    string
+	OverviewSuffix string
+}{
+	{"ja", " (日本語)", " (日本語翻訳)"},
+	{"es", " (Español)", " (Traducción al español)"},
+	{"fr", " (Français)", " (Traduction française)"},
+}
+
+// stillMovies is how many movies (from the top of the list) get still images.
+const stillMovies = 10
+
+// Rows below mirror the tables they are inserted into.
+
+type Language struct {
+	ISO        string
+	Name       string // English name
+	NativeName string
+}
+
+type Genre struct {
+	ID   uuid.UUID
+	Name string
+}
+
+type GenreTranslation struct {
+	GenreID  uuid.UUID
+	Language string
+	Name     string
+}
+
+type Company struct {
+	ID            uuid.UUID
+	Name          string
+	OriginCountry string
+	Homepage      string
+}
+
+type Person struct {
+	ID          uuid.UUID
+	Name        string
+	KnownFor    string
+	ProfilePath string
+}
+
+type Movie struct {
+	ID               uuid.UUID
+	ExternalID       string // set for imported movies only
+	Title            string
+	OriginalTitle    string
+	OriginalLanguage string
+	TagLine          string
+	Overview         string
+	ReleaseDate      string // YYYY-MM-DD
+	Runtime          int
+	Adult            bool
+	Homepage         string
+	PosterPath       string
+	BackdropPath     string
+	Budget           int64
+	Revenue          int64
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+
+	// movie_stats
+	Popularity     float64
+	BasePopularity float64 // what views are added to when popularity is refreshed
+	VoteAverage    float64
+	VoteCount      int
+
+	GenreIDs        []uuid.UUID // movie_genres
+	CompanyIDs      []uuid.UUID // movie_companies
+	SpokenLanguages []string    // movie_spoken_languages
+}
+
+type Credit struct {
+	ID            uuid.UUID
+	MovieID       uuid.UUID
+	PersonID      uuid.UUID
+	CreditType    string // cast or crew
+	Department    *string
+	Job           *string
+	CharacterName *string
+	CastOrder     *int
+}
+
+type Image struct {
+	ID       uuid.UUID
+	MovieID  uuid.UUID
+	FilePath string
+	Type     string // poster, backdrop or still
+	Width    int
+	Height   int
+	Language *string
+}
+
+type Video struct {
+	ID          uuid.UUID
+	MovieID     uuid.UUID
+	Site        string
+	Key         string
+	Name        string
+	Type        string // Trailer, Teaser, Clip, Featurette, ...
+	Official    bool
+	Language    *string
+	PublishedAt *time.Time
+}
+
+type Translation struct {
+	MovieID  uuid.UUID
+	Language string
+	Title    string
+	Overview string
+}
+
+// Dataset is the fully resolved catalogue: every name reference in the raw
+// tables has been replaced by the UUID of the row it points to.
+type Dataset struct {
+	Languages         []Language
+	Genres            []Genre
+	GenreTranslations []GenreTranslation
+	Companies         []Company
+	People            []Person
+	Movies            []Movie
+	Credits           []Credit
+	Images            []Image
+	Videos            []Video
+	Translations      []Translation
+}
+
+// Build generates fresh UUIDv7 ids and resolves the sample catalogue.
+// Every movie gets now as its created_at / updated_at.
+func Build(now time.Time) *Dataset {
+	ds := &Dataset{}
+
+	for _, l := range languageSeeds {
+		ds.Languages = append(ds.Languages, Language{ISO: l.ISO, Name: l.Name, NativeName: l.NativeName})
+	}
+
+	genreIDs := make(map[string]uuid.UUID)
+	for _, g := range genreNames {
+		id := newUUID()
+		genreIDs[g] = id
+		ds.Genres = append(ds.Genres, Genre{ID: id, Name: g})
+		for _, t := range translationLanguages {
+			if name, ok := genreTranslations[g][t.Lang]; ok {
+				ds.GenreTranslations = append(ds.GenreTranslations, GenreTranslation{GenreID: id, Language: t.Lang, Name: name})
+			}
+		}
+	}
+
+	companyIDs := make(map[string]uuid.UUID)
+	for _, c := range companySeeds {
+		id := newUUID()
+		companyIDs[c.Name] = id
+		ds.Companies = append(ds.Companies, Company{ID: id, Name: c.Name, OriginCountry: c.Country, Homepage: c.Homepage})
+	}
+
+	personIDs := make(map[string]uuid.UUID)
+	for _, p := range personSeeds {
+		id := newUUID()
+		personIDs[p.Name] = id
+		ds.People = append(ds.People, Person{ID: id, Name: p.Name, KnownFor: p.KnownFor, ProfilePath: p.ProfilePath})
+	}
+
+	for i, m := range movieSeeds {
+		id := newUUID()
+		movie := Movie{
+			ID:               id,
+			Title:            m.Title,
+			OriginalTitle:    m.OriginalTitle,
+			OriginalLanguage: m.Lang,
+			TagLine:          m.TagLine,
+			Overview:         m.Overview,
+			ReleaseDate:      m.Release,
+			Runtime:          m.Runtime,
+			Adult:            m.Adult,
+			Homepage:         m.Homepage,
+			PosterPath:       m.PosterPath,
+			BackdropPath:     m.BackdropPath,
+			Budget:           m.Budget,
+			Revenue:          m.Revenue,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+			Popularity:       m.Popularity,
+			BasePopularity:   m.Popularity,
+			VoteAverage:      m.VoteAverage,
+			VoteCount:        m.VoteCount,
+			SpokenLanguages:  m.SpokenLanguages,
+		}
+		for _, g := range m.Genres {
+			if gid, ok := genreIDs[g]; ok {
+				movie.GenreIDs = append(movie.GenreIDs, gid)
+			}
+		}
+		for _, c := range m.Companies {
+			if cid, ok := companyIDs[c]; ok {
+				movie.CompanyIDs = append(movie.CompanyIDs, cid)
+			}
+		}
+		ds.Movies = append(ds.Movies, movie)
+
+		// cast
+		for order, name := range m.CastMembers {
+			if pid, ok := personIDs[name]; ok {
+				ds.Credits = append(ds.Credits, Credit{
+					ID: newUUID(), MovieID: id, PersonID: pid, CreditType: "cast",
+					CharacterName: ptr("Character " + name), CastOrder: ptr(order + 1),
+				})
+			}
+		}
+
+		// crew
+		ds.addCrew(personIDs, id, m.Director, "Directing", "Director")
+		if m.Writer != m.Director {
+			ds.addCrew(personIDs, id, m.Writer, "Writing", "Screenplay")
+		}
+		ds.addCrew(personIDs, id, m.Composer, "Sound", "Original Music Composer")
+
+		// images
+		ds.Images = append(ds.Images,
+			Image{ID: newUUID(), MovieID: id, FilePath: m.PosterPath, Type: "poster", Width: 500, Height: 750, Language: ptr(m.Lang)},
+			Image{ID: newUUID(), MovieID: id, FilePath: m.BackdropPath, Type: "backdrop", Width: 1920, Height: 1080},
+		)
+		if i < stillMovies {
+			for j := 1; j <= 3; j++ {
+				ds.Images = append(ds.Images, Image{
+					ID: newUUID(), MovieID: id, Type: "still", Width: 1280, Height: 720,
+					FilePath: "/stills/" + m.Title + "_still_" + strconv.Itoa(j) + ".jpg",
+				})
+			}
+		}
+
+		// videos
+		ds.addVideo(id, m, "Official Trailer", "Trailer", "en", 90)
+		if m.Lang != "en" {
+			ds.addVideo(id, m, "Official Trailer ("+m.Lang+")", "Trailer", m.Lang, 90)
+		}
+		if i < stillMovies {
+			ds.addVideo(id, m, "Teaser", "Teaser", "en", 180)
+			ds.addVideo(id, m, "Behind the Scenes", "Featurette", "", 30)
+		}
+		if m.HasTranslations {
+			for _, t := range translationLanguages {
+				ds.addVideo(id, m, "Trailer"+t.TitleSuffix, "Trailer", t.Lang, 60)
+			}
+		}
+
+		// translations
+		if m.HasTranslations {
+			for _, t := range translationLanguages {
+				ds.Translations = append(ds.Translations, Translation{
+					MovieID:  id,
+					Language: t.Lang,
+					Title:    m.Title + t.TitleSuffix,
+					Overview: m.Overview + t.OverviewSuffix,
+				})
+			}
+		}
+	}
+
+	for _, c := range cinematographerSeeds {
+		if c.MovieIndex < len(ds.Movies) {
+			ds.addCrew(personIDs, ds.Movies[c.MovieIndex].ID, c.Person, "Camera", "Director of Photography")
+		}
+	}
+
+	return ds
+}
+
+func (ds *Dataset) addCrew(personIDs map[string]uuid.UUID, movieID uuid.UUID, name, department, job string) {
+	if name == "" {
+		return
+	}
+	if pid, ok := personIDs[name]; ok {
+		ds.Credits = append(ds.Credits, Credit{
+			ID: newUUID(), MovieID: movieID, PersonID: pid, CreditType: "crew",
+			Department: ptr(department), Job: ptr(job),
+		})
+	}
+}
+
+// addVideo adds an official YouTube video published daysBefore the movie's
+// release. An empty lang means the video has no language.
+func (ds *Dataset) addVideo(movieID uuid.UUID, m movieSeed, name, typ, lang string, daysBefore int) {
+	sum := sha1.Sum([]byte(m.Title + "/" + name))
+	v := Video{
+		ID: newUUID(), MovieID: movieID, Site: "YouTube", Name: name, Type: typ, Official: true,
+		Key: base64.RawURLEncoding.EncodeToString(sum[:])[:11],
+	}
+	if lang != "" {
+		v.Language = ptr(lang)
+	}
+	if release, err := time.Parse(time.DateOnly, m.Release); err == nil {
+		v.PublishedAt = ptr(release.AddDate(0, 0, -daysBefore))
+	}
+	ds.Videos = append(ds.Videos, v)
+}
+
+func newUUID() uuid.UUID {
+	return uuid.Must(uuid.NewV7())
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
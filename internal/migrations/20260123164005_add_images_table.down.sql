@@ -1 +1,2 @@
-DROP TABLE IF EXISTS images;
\ No newline at end of file
+DROP TABLE IF EXISTS images;
+DROP TYPE IF EXISTS image_type;
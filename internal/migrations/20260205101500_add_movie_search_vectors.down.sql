@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_movie_translations_search_vector;
+DROP INDEX IF EXISTS idx_movies_search_vector;
+ALTER TABLE movie_translations DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE movies DROP COLUMN IF EXISTS search_vector;
+DROP FUNCTION IF EXISTS movie_ts_config(TEXT);
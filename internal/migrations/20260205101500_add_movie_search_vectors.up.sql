@@ -0,0 +1,41 @@
+-- Maps a request language (en, en-US, pt-BR, ...) to the text search config
+-- used to stem text in that language. Unknown languages are not stemmed.
+CREATE OR REPLACE FUNCTION movie_ts_config(lang TEXT) RETURNS regconfig AS $$
+  SELECT CASE lower(split_part(coalesce(lang, ''), '-', 1))
+    WHEN 'ar' THEN 'arabic'
+    WHEN 'da' THEN 'danish'
+    WHEN 'de' THEN 'german'
+    WHEN 'en' THEN 'english'
+    WHEN 'es' THEN 'spanish'
+    WHEN 'fi' THEN 'finnish'
+    WHEN 'fr' THEN 'french'
+    WHEN 'hi' THEN 'hindi'
+    WHEN 'hu' THEN 'hungarian'
+    WHEN 'it' THEN 'italian'
+    WHEN 'nl' THEN 'dutch'
+    WHEN 'no' THEN 'norwegian'
+    WHEN 'pt' THEN 'portuguese'
+    WHEN 'ro' THEN 'romanian'
+    WHEN 'ru' THEN 'russian'
+    WHEN 'sv' THEN 'swedish'
+    WHEN 'tr' THEN 'turkish'
+    ELSE 'simple'
+  END::regconfig
+$$ LANGUAGE sql IMMUTABLE;
+
+-- Base movie text is English; original_title is in the movie's own language,
+-- so it is indexed unstemmed.
+ALTER TABLE movies ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+  setweight(to_tsvector('simple', coalesce(original_title, '')), 'A') ||
+  setweight(to_tsvector('english', coalesce(tag_line, '')), 'B') ||
+  setweight(to_tsvector('english', coalesce(overview, '')), 'C')
+) STORED;
+
+ALTER TABLE movie_translations ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector(movie_ts_config(language), coalesce(title, '')), 'A') ||
+  setweight(to_tsvector(movie_ts_config(language), coalesce(overview, '')), 'C')
+) STORED;
+
+CREATE INDEX idx_movies_search_vector ON movies USING GIN (search_vector);
+CREATE INDEX idx_movie_translations_search_vector ON movie_translations USING GIN (search_vector);
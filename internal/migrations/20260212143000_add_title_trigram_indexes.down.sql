@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_movie_translations_title_trgm;
+DROP INDEX IF EXISTS idx_movies_original_title_trgm;
+DROP INDEX IF EXISTS idx_movies_title_trgm;
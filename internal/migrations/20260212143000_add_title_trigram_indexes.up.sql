@@ -0,0 +1,5 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_movies_title_trgm ON movies USING GIN (title gin_trgm_ops);
+CREATE INDEX idx_movies_original_title_trgm ON movies USING GIN (original_title gin_trgm_ops);
+CREATE INDEX idx_movie_translations_title_trgm ON movie_translations USING GIN (title gin_trgm_ops);
@@ -0,0 +1,6 @@
+CREATE TABLE genre_translations (
+  genre_id UUID REFERENCES genres(id) ON DELETE CASCADE,
+  language VARCHAR(10) NOT NULL,
+  name     TEXT NOT NULL,
+  PRIMARY KEY (genre_id, language)
+);
@@ -0,0 +1,14 @@
+CREATE TABLE videos (
+  id UUID PRIMARY KEY,
+  movie_id UUID REFERENCES movies(id) ON DELETE CASCADE,
+  site TEXT NOT NULL,                -- e.g. YouTube, Vimeo
+  key TEXT NOT NULL,                 -- the video id on site
+  name TEXT NOT NULL,
+  type TEXT NOT NULL CHECK (type IN ('Trailer', 'Teaser', 'Clip', 'Featurette', 'Behind the Scenes', 'Bloopers')),
+  official BOOLEAN NOT NULL DEFAULT false,
+  language VARCHAR(10),
+  published_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX idx_videos_movie_id ON videos (movie_id);
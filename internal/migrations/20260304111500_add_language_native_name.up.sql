@@ -0,0 +1 @@
+ALTER TABLE languages ADD COLUMN native_name TEXT;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_people_name;
+DROP INDEX IF EXISTS idx_companies_name;
+DROP INDEX IF EXISTS idx_movies_external_id;
+ALTER TABLE movies DROP COLUMN IF EXISTS external_id;
@@ -0,0 +1,8 @@
+-- external_id is the id a movie has in the catalogue it was imported from,
+-- so re-running an import updates movies instead of duplicating them.
+ALTER TABLE movies ADD COLUMN external_id TEXT;
+CREATE UNIQUE INDEX idx_movies_external_id ON movies (external_id);
+
+-- imports resolve companies and people by name
+CREATE INDEX idx_companies_name ON companies (name);
+CREATE INDEX idx_people_name ON people (name);
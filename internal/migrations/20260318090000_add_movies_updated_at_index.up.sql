@@ -0,0 +1,2 @@
+-- incremental exports select movies by updated_at
+CREATE INDEX idx_movies_updated_at ON movies (updated_at);
@@ -0,0 +1,13 @@
+-- user_id has no foreign key: users may be identified by an upstream
+-- gateway rather than the users table
+CREATE TABLE ratings (
+  user_id UUID NOT NULL,
+  movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+  value NUMERIC(3,1) NOT NULL CHECK (value BETWEEN 0.5 AND 10 AND value * 2 = trunc(value * 2)),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (user_id, movie_id)
+);
+
+-- the rated list is newest first
+CREATE INDEX idx_ratings_user_updated ON ratings (user_id, updated_at DESC);
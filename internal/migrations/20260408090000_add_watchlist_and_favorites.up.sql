@@ -0,0 +1,18 @@
+-- like ratings, user_id has no foreign key so gateway identities work too
+CREATE TABLE watchlist (
+  user_id UUID NOT NULL,
+  movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (user_id, movie_id)
+);
+
+CREATE TABLE favorites (
+  user_id UUID NOT NULL,
+  movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (user_id, movie_id)
+);
+
+-- both lists are newest first
+CREATE INDEX idx_watchlist_user_created ON watchlist (user_id, created_at DESC);
+CREATE INDEX idx_favorites_user_created ON favorites (user_id, created_at DESC);
@@ -0,0 +1,17 @@
+-- one review per author and movie; editing it sends it back to moderation.
+-- author_id has no foreign key, like ratings.user_id
+CREATE TABLE reviews (
+  id UUID PRIMARY KEY,
+  movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+  author_id UUID NOT NULL,
+  rating NUMERIC(3,1) CHECK (rating BETWEEN 0.5 AND 10 AND rating * 2 = trunc(rating * 2)),
+  content TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  UNIQUE (movie_id, author_id)
+);
+
+-- a movie's reviews newest first, and the moderation queue oldest first
+CREATE INDEX idx_reviews_movie_created ON reviews (movie_id, created_at DESC);
+CREATE INDEX idx_reviews_status_updated ON reviews (status, updated_at);
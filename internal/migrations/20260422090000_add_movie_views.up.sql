@@ -0,0 +1,11 @@
+-- views are counted per movie and hour rather than stored one row per
+-- request; trending scores and popularity are computed from the counts
+CREATE TABLE movie_views (
+  movie_id UUID NOT NULL REFERENCES movies(id) ON DELETE CASCADE,
+  hour TIMESTAMPTZ NOT NULL,
+  views INT NOT NULL CHECK (views > 0),
+  PRIMARY KEY (movie_id, hour)
+);
+
+-- trending windows and pruning scan by hour
+CREATE INDEX idx_movie_views_hour ON movie_views (hour);
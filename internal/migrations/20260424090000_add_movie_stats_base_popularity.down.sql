@@ -0,0 +1 @@
+ALTER TABLE movie_stats DROP COLUMN IF EXISTS base_popularity;
@@ -0,0 +1,6 @@
+-- popularity is recomputed from views as base_popularity plus the week's
+-- decayed view score; base_popularity keeps the seeded, imported or
+-- admin-set value the views are added to
+ALTER TABLE movie_stats ADD COLUMN base_popularity DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+UPDATE movie_stats SET base_popularity = COALESCE(popularity, 0);
@@ -0,0 +1,10 @@
+// Package migrations embeds the versioned schema files so the binary can
+// apply them without the SQL being present on disk.
+package migrations
+
+import "embed"
+
+// FS holds every <version>_<name>.up.sql / .down.sql pair in this directory.
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,26 @@
+package model
+
+import "github.com/gofrs/uuid/v5"
+
+// AccountStates is the account_states append: whether the caller has the
+// movie on their watchlist or favorites, and their rating of it.
+type AccountStates struct {
+	ID        uuid.UUID `json:"id"`
+	Watchlist bool      `json:"watchlist"`
+	Favorite  bool      `json:"favorite"`
+	Rated     *float64  `json:"rated"` // null if the caller hasn't rated it
+}
+
+// WatchlistWrite is the body of POST /account/watchlist. Watchlist false
+// removes the movie.
+type WatchlistWrite struct {
+	MovieID   string `json:"movie_id"`
+	Watchlist *bool  `json:"watchlist"`
+}
+
+// FavoriteWrite is the body of POST /account/favorite. Favorite false
+// removes the movie.
+type FavoriteWrite struct {
+	MovieID  string `json:"movie_id"`
+	Favorite *bool  `json:"favorite"`
+}
@@ -0,0 +1,18 @@
+package model
+
+import "github.com/gofrs/uuid/v5"
+
+// ProductionCompany is a company as listed on a movie.
+type ProductionCompany struct {
+	ID            uuid.UUID `json:"id"`
+	Name          string    `json:"name"`
+	OriginCountry *string   `json:"origin_country,omitempty"`
+}
+
+type CompanyResponse struct {
+	ID            uuid.UUID               `json:"id"`
+	Name          string                  `json:"name"`
+	OriginCountry *string                 `json:"origin_country,omitempty"`
+	Homepage      *string                 `json:"homepage,omitempty"`
+	Movies        *DiscoverMoviesResponse `json:"movies,omitempty"`
+}
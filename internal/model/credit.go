@@ -0,0 +1,25 @@
+package model
+
+import "github.com/gofrs/uuid/v5"
+
+// MovieCredits is a movie's cast, in billing order, and its crew, grouped
+// by department.
+type MovieCredits struct {
+	Cast []MovieCredit `json:"cast"`
+	Crew []MovieCredit `json:"crew"`
+}
+
+// MovieCredit is one credit on a movie: Character and CastOrder are set for
+// cast credits, Department and Job for crew credits. ID is the person's id.
+type MovieCredit struct {
+	CreditID    uuid.UUID `json:"credit_id"`
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	KnownFor    *string   `json:"known_for,omitempty"`
+	ProfilePath *string   `json:"profile_path,omitempty"`
+	CreditType  string    `json:"credit_type"`
+	Character   *string   `json:"character,omitempty"`
+	CastOrder   *int      `json:"cast_order,omitempty"`
+	Department  *string   `json:"department,omitempty"`
+	Job         *string   `json:"job,omitempty"`
+}
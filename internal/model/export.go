@@ -0,0 +1,15 @@
+package model
+
+import "github.com/gofrs/uuid/v5"
+
+// ExportAppends holds the appended rows of a batch of exported movies,
+// keyed by movie id. Movies without rows of a kind map to nil.
+type ExportAppends struct {
+	Genres          map[uuid.UUID][]Genre
+	Companies       map[uuid.UUID][]ProductionCompany
+	Credits         map[uuid.UUID][]MovieCredit
+	Images          map[uuid.UUID][]Image
+	Videos          map[uuid.UUID][]Video
+	SpokenLanguages map[uuid.UUID][]Language
+	Translations    map[uuid.UUID][]MovieTranslation
+}
@@ -0,0 +1,12 @@
+package model
+
+import "github.com/gofrs/uuid/v5"
+
+type Genre struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+type GenreListResponse struct {
+	Genres []Genre `json:"genres"`
+}
@@ -0,0 +1,17 @@
+package model
+
+// Image is one poster, backdrop or still of a movie.
+type Image struct {
+	Type        string  `json:"-"` // poster, backdrop or still
+	FilePath    string  `json:"file_path"`
+	Width       int     `json:"width"`
+	Height      int     `json:"height"`
+	AspectRatio float64 `json:"aspect_ratio"`
+	Language    *string `json:"language"` // nil for images without text
+}
+
+type MovieImages struct {
+	Posters   []Image `json:"posters"`
+	Backdrops []Image `json:"backdrops"`
+	Stills    []Image `json:"stills"`
+}
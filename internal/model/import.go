@@ -0,0 +1,72 @@
+package model
+
+// ImportMovie is one line of an import file: a movie with the genres,
+// companies and people it links to referenced by name.
+type ImportMovie struct {
+	ExternalID       string   `json:"external_id"`
+	Title            string   `json:"title"`
+	OriginalTitle    *string  `json:"original_title"`
+	OriginalLanguage *string  `json:"original_language"`
+	TagLine          *string  `json:"tag_line"`
+	Overview         *string  `json:"overview"`
+	ReleaseDate      *string  `json:"release_date"` // YYYY-MM-DD
+	Runtime          *int     `json:"runtime"`
+	Adult            bool     `json:"adult"`
+	Homepage         *string  `json:"homepage"`
+	PosterPath       *string  `json:"poster_path"`
+	BackdropPath     *string  `json:"backdrop_path"`
+	Budget           int64    `json:"budget"`
+	Revenue          int64    `json:"revenue"`
+	Popularity       float64  `json:"popularity"`
+	VoteAverage      float64  `json:"vote_average"`
+	VoteCount        int      `json:"vote_count"`
+	Genres           []string `json:"genres"`
+	Companies        []string `json:"companies"`
+	SpokenLanguages  []string `json:"spoken_languages"` // iso_639_1 codes
+
+	Credits      []ImportCredit      `json:"credits"`
+	Images       []ImportImage       `json:"images"`
+	Translations []ImportTranslation `json:"translations"`
+}
+
+// ImportCredit is a cast or crew credit. The person is matched by name;
+// KnownFor and ProfilePath are only used when the person is new.
+type ImportCredit struct {
+	Name        string  `json:"name"`
+	KnownFor    *string `json:"known_for"`
+	ProfilePath *string `json:"profile_path"`
+	CreditType  string  `json:"credit_type"` // cast or crew
+	Character   *string `json:"character"`
+	CastOrder   *int    `json:"cast_order"`
+	Department  *string `json:"department"`
+	Job         *string `json:"job"`
+}
+
+type ImportImage struct {
+	Type     string  `json:"type"` // poster, backdrop or still
+	FilePath string  `json:"file_path"`
+	Width    int     `json:"width"`
+	Height   int     `json:"height"`
+	Language *string `json:"language"`
+}
+
+type ImportTranslation struct {
+	Language string  `json:"language"`
+	Title    *string `json:"title"`
+	Overview *string `json:"overview"`
+}
+
+// ImportSummary counts what an import did. Errors holds the first skipped
+// lines and why they were skipped.
+type ImportSummary struct {
+	Inserted int           `json:"inserted"`
+	Updated  int           `json:"updated"`
+	Skipped  int           `json:"skipped"`
+	Errors   []ImportError `json:"errors,omitempty"`
+}
+
+type ImportError struct {
+	Line       int    `json:"line"`
+	ExternalID string `json:"external_id,omitempty"`
+	Message    string `json:"message"`
+}
@@ -0,0 +1,7 @@
+package model
+
+type Language struct {
+	ISO6391     string `json:"iso_639_1"`
+	EnglishName string `json:"english_name"`
+	Name        string `json:"name"` // native name
+}
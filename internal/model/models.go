@@ -1,31 +1,56 @@
 package model
 
-import "github.com/gofrs/uuid/v5"
+import (
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/pagination"
+)
 
 type MovieResponse struct {
-	ID                  uuid.UUID          `json:"id"`
-	Title               string             `json:"title"`
-	Overview            *string            `json:"overview,omitempty"`
-	ReleaseDate         *string            `json:"release_date,omitempty"`
-	VoteAverage         *float64           `json:"vote_average,omitempty"`
-	VoteCount           *int               `json:"vote_count,omitempty"`
-	PosterPath          *string            `json:"poster_path,omitempty"`
-	BackdropPath        *string            `json:"backdrop_path,omitempty"`
-	Budget              *int64             `json:"budget,omitempty"`
-	Revenue             *int64             `json:"revenue,omitempty"`
-	Genres              []string           `json:"genres,omitempty"`
-	ProductionCompanies []string           `json:"production_companies,omitempty"`
-	SpokenLanguages     []map[string]any   `json:"spoken_languages,omitempty"`
-	Homepage            *string            `json:"homepage,omitempty"`
-	Credits             []Credits_Response `json:"credits,omitempty"`
-	Videos              []map[string]any   `json:"videos,omitempty"`
-	Images              []map[string]any   `json:"images,omitempty"`
+	ID                  uuid.UUID               `json:"id"`
+	Title               string                  `json:"title"`
+	Overview            *string                 `json:"overview,omitempty"`
+	ReleaseDate         *string                 `json:"release_date,omitempty"`
+	VoteAverage         *float64                `json:"vote_average,omitempty"`
+	VoteCount           *int                    `json:"vote_count,omitempty"`
+	PosterPath          *string                 `json:"poster_path,omitempty"`
+	BackdropPath        *string                 `json:"backdrop_path,omitempty"`
+	Budget              *int64                  `json:"budget,omitempty"`
+	Revenue             *int64                  `json:"revenue,omitempty"`
+	Genres              []Genre                 `json:"genres,omitempty"`
+	ProductionCompanies []ProductionCompany     `json:"production_companies,omitempty"`
+	SpokenLanguages     []Language              `json:"spoken_languages,omitempty"`
+	Homepage            *string                 `json:"homepage,omitempty"`
+	UpdatedAt           time.Time               `json:"updated_at"` // movies.updated_at, the Last-Modified of the movie route
+	Credits             *MovieCredits           `json:"credits,omitempty"`
+	Videos              []Video                 `json:"videos,omitempty"`
+	Images              *MovieImages            `json:"images,omitempty"`
+	Translations        []MovieTranslation      `json:"translations,omitempty"`
+	Reviews             *ReviewsResponse        `json:"reviews,omitempty"`
+	Similar             *DiscoverMoviesResponse `json:"similar,omitempty"`
+	AccountStates       *AccountStates          `json:"account_states,omitempty"`
+}
+
+// LanguageFilter restricts appended images (or videos) to the given
+// languages, plus the ones without a language when IncludeNull is set. The
+// zero value matches everything.
+type LanguageFilter struct {
+	Languages   []string
+	IncludeNull bool
 }
 
-type Credits_Response struct {
-	Name        string `json:"name"`
-	Known_for   string `json:"known_for"`
-	Credit_type string `json:"credit_type"`
+// Active reports whether the filter restricts anything.
+func (f LanguageFilter) Active() bool {
+	return len(f.Languages) > 0 || f.IncludeNull
+}
+
+// AppendOptions carries the query parameters that refine appended fields.
+type AppendOptions struct {
+	ImageLanguages LanguageFilter // include_image_language
+	VideoLanguages LanguageFilter // include_video_language, on top of the request language
+	IncludeAdult   bool           // include_adult, for similar
 }
 
 type MovieSearchItem struct {
@@ -35,13 +60,17 @@ type MovieSearchItem struct {
 	ReleaseDate *string   `json:"release_date,omitempty"`
 	VoteAverage *float64  `json:"vote_average,omitempty"`
 	Popularity  *float64  `json:"popularity,omitempty"`
+	Score       float64   `json:"score"`                // relevance blended with popularity, higher is better
+	Similarity  *float64  `json:"similarity,omitempty"` // trigram similarity, fuzzy results only
 }
 
 type SearchResponse struct {
 	Page         int               `json:"page"`
+	Fuzzy        bool              `json:"fuzzy,omitempty"` // results come from typo-tolerant title matching
 	TotalResults int               `json:"total_results"`
 	TotalPages   int               `json:"total_pages"`
 	Results      []MovieSearchItem `json:"results"`
+	NextCursor   string            `json:"next_cursor,omitempty"`
 }
 
 type DiscoverMoviesParams struct {
@@ -56,6 +85,36 @@ type DiscoverMoviesParams struct {
 	SortBy         string // e.g., "popularity.desc"
 	Page           int
 	PageSize       int
+	Cursor         string // next_cursor of the previous page; takes precedence over Page
+}
+
+// discoverSortFields are the sort_by fields DiscoverMovies supports.
+var discoverSortFields = map[string]bool{
+	"popularity":   true,
+	"release_date": true,
+	"vote_average": true,
+}
+
+// SortKey normalises SortBy to a supported field and direction ("asc" or
+// "desc"). Unknown fields sort by popularity, unknown directions descend.
+func (p DiscoverMoviesParams) SortKey() (field, dir string) {
+	parts := strings.SplitN(p.SortBy, ".", 2)
+	field, dir = parts[0], "desc"
+	if !discoverSortFields[field] {
+		field = "popularity"
+	}
+	if len(parts) == 2 && strings.ToLower(parts[1]) == "asc" {
+		dir = "asc"
+	}
+	return field, dir
+}
+
+// CursorKey is the pagination.QueryKey of the filters, which a cursor must
+// have been issued for. The sort is checked on its own and the page size may
+// change between pages.
+func (p DiscoverMoviesParams) CursorKey() string {
+	return pagination.QueryKey(p.WithGenres, p.WithGenresAND, p.IncludeAdult, p.Language,
+		p.ReleaseDateGTE, p.ReleaseDateLTE, p.VoteAvgGTE, p.VoteAvgLTE)
 }
 
 type DiscoverItem struct {
@@ -77,4 +136,5 @@ type DiscoverMoviesResponse struct {
 	TotalResults int            `json:"total_results"`
 	TotalPages   int            `json:"total_pages"`
 	Results      []DiscoverItem `json:"results"`
+	NextCursor   string         `json:"next_cursor,omitempty"`
 }
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// MovieWrite is the body of the admin movie endpoints. On PATCH a nil field
+// is left as it is; on POST and PUT it is stored as NULL, or as the column
+// default for adult, budget, revenue and popularity. Nil id lists mean no
+// genres, companies or spoken languages, except on PATCH. vote_average and
+// vote_count are kept from the movie's ratings and cannot be written.
+type MovieWrite struct {
+	Title            *string  `json:"title"`
+	OriginalTitle    *string  `json:"original_title"`
+	OriginalLanguage *string  `json:"original_language"`
+	TagLine          *string  `json:"tag_line"`
+	Overview         *string  `json:"overview"`
+	ReleaseDate      *string  `json:"release_date"` // YYYY-MM-DD
+	Runtime          *int     `json:"runtime"`
+	Adult            *bool    `json:"adult"`
+	Homepage         *string  `json:"homepage"`
+	PosterPath       *string  `json:"poster_path"`
+	BackdropPath     *string  `json:"backdrop_path"`
+	Budget           *int64   `json:"budget"`
+	Revenue          *int64   `json:"revenue"`
+	Popularity       *float64 `json:"popularity"`
+
+	GenreIDs        *[]uuid.UUID `json:"genre_ids"`
+	CompanyIDs      *[]uuid.UUID `json:"company_ids"`
+	SpokenLanguages *[]string    `json:"spoken_languages"` // iso_639_1 codes
+}
+
+// TranslationWrite is the body of PUT /api/admin/movies/:id/translations/:lang.
+// A nil field is stored as NULL, so the base movie's text is shown instead.
+type TranslationWrite struct {
+	Title    *string `json:"title"`
+	Overview *string `json:"overview"`
+}
+
+// FieldError is one invalid field of a write request.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError is returned for input that can't be stored, whether the
+// service rejected it up front or the store found a dangling reference.
+type ValidationError struct {
+	Errors []FieldError `json:"errors"`
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, f := range e.Errors {
+		msgs[i] = f.Field + ": " + f.Message
+	}
+	return "validation failed: " + strings.Join(msgs, "; ")
+}
+
+// Add records an invalid field.
+func (e *ValidationError) Add(field, message string) {
+	e.Errors = append(e.Errors, FieldError{Field: field, Message: message})
+}
+
+// OrNil returns e if any field was added, nil otherwise.
+func (e *ValidationError) OrNil() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return e
+}
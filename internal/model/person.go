@@ -0,0 +1,33 @@
+package model
+
+import "github.com/gofrs/uuid/v5"
+
+type PersonResponse struct {
+	ID           uuid.UUID           `json:"id"`
+	Name         string              `json:"name"`
+	KnownFor     *string             `json:"known_for,omitempty"`
+	ProfilePath  *string             `json:"profile_path,omitempty"`
+	MovieCredits *PersonMovieCredits `json:"movie_credits,omitempty"`
+}
+
+// PersonMovieCredits is a person's filmography, each list sorted by release
+// date (movies without one last).
+type PersonMovieCredits struct {
+	Cast []PersonMovieCredit `json:"cast"`
+	Crew []PersonMovieCredit `json:"crew"`
+}
+
+// PersonMovieCredit is one credit of a person: Character is set for cast
+// credits, Department and Job for crew credits.
+type PersonMovieCredit struct {
+	CreditID    uuid.UUID `json:"credit_id"`
+	MovieID     uuid.UUID `json:"movie_id"`
+	Title       string    `json:"title"`
+	ReleaseDate *string   `json:"release_date,omitempty"`
+	PosterPath  *string   `json:"poster_path,omitempty"`
+	CreditType  string    `json:"credit_type"`
+	Character   *string   `json:"character,omitempty"`
+	CastOrder   *int      `json:"cast_order,omitempty"`
+	Department  *string   `json:"department,omitempty"`
+	Job         *string   `json:"job,omitempty"`
+}
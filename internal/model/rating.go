@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// RatingWrite is the body of POST /movie/:id/rating.
+type RatingWrite struct {
+	Value float64 `json:"value"` // 0.5 to 10 in steps of 0.5
+}
+
+// MovieRating is the caller's rating of a movie and the movie's vote
+// aggregates after it was applied. Rating is nil once deleted.
+type MovieRating struct {
+	MovieID     uuid.UUID `json:"movie_id"`
+	Rating      *float64  `json:"rating"`
+	VoteAverage float64   `json:"vote_average"`
+	VoteCount   int       `json:"vote_count"`
+}
+
+// RatedMovie is a movie in the caller's rated list.
+type RatedMovie struct {
+	DiscoverItem
+	Rating  float64   `json:"rating"`
+	RatedAt time.Time `json:"rated_at"`
+}
+
+type RatedMoviesResponse struct {
+	Page         int          `json:"page"`
+	PageSize     int          `json:"page_size"`
+	TotalResults int          `json:"total_results"`
+	TotalPages   int          `json:"total_pages"`
+	Results      []RatedMovie `json:"results"`
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// Review statuses. New and edited reviews are pending until an admin
+// approves or rejects them; only approved ones are public.
+const (
+	ReviewPending  = "pending"
+	ReviewApproved = "approved"
+	ReviewRejected = "rejected"
+)
+
+// ValidReviewStatus reports whether s is one of the review statuses.
+func ValidReviewStatus(s string) bool {
+	return s == ReviewPending || s == ReviewApproved || s == ReviewRejected
+}
+
+// ReviewWrite is the body of POST /movie/:id/reviews.
+type ReviewWrite struct {
+	Rating  *float64 `json:"rating"` // optional, 0.5 to 10 in steps of 0.5
+	Content string   `json:"content"`
+}
+
+type Review struct {
+	ID        uuid.UUID `json:"id"`
+	MovieID   uuid.UUID `json:"movie_id"`
+	AuthorID  uuid.UUID `json:"author_id"`
+	Rating    *float64  `json:"rating"`
+	Content   string    `json:"content"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ReviewsResponse struct {
+	Page         int      `json:"page"`
+	PageSize     int      `json:"page_size"`
+	TotalResults int      `json:"total_results"`
+	TotalPages   int      `json:"total_pages"`
+	Results      []Review `json:"results"`
+}
@@ -0,0 +1,23 @@
+package model
+
+import "github.com/gofrs/uuid/v5"
+
+type TranslationsResponse struct {
+	ID           uuid.UUID          `json:"id"`
+	Translations []MovieTranslation `json:"translations"`
+}
+
+// MovieTranslation is one movie_translations row. EnglishName and Name (the
+// native name) come from languages and are null for a language code it
+// doesn't know.
+type MovieTranslation struct {
+	ISO6391     string          `json:"iso_639_1"`
+	EnglishName *string         `json:"english_name"`
+	Name        *string         `json:"name"`
+	Data        TranslationData `json:"data"`
+}
+
+type TranslationData struct {
+	Title    *string `json:"title"`
+	Overview *string `json:"overview"`
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// User is a registered account. The password hash never leaves the server.
+type User struct {
+	ID           uuid.UUID `json:"id"`
+	Email        string    `json:"email"`
+	PasswordHash string    `json:"-"` // bcrypt
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Credentials is the body of register and login.
+type Credentials struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// TokenPair is a short-lived access token, sent as "Authorization: Bearer",
+// and the refresh token that gets a new pair once it expires.
+type TokenPair struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"` // always Bearer
+	ExpiresIn    int    `json:"expires_in"` // access token lifetime in seconds
+}
+
+type AuthResponse struct {
+	User User `json:"user"`
+	TokenPair
+}
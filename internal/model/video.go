@@ -0,0 +1,15 @@
+package model
+
+import "github.com/gofrs/uuid/v5"
+
+// Video is a trailer, teaser, clip or featurette hosted on Site under Key.
+type Video struct {
+	ID          uuid.UUID `json:"id"`
+	Site        string    `json:"site"`
+	Key         string    `json:"key"`
+	Name        string    `json:"name"`
+	Type        string    `json:"type"`
+	Official    bool      `json:"official"`
+	Language    *string   `json:"language"`
+	PublishedAt *string   `json:"published_at,omitempty"` // RFC 3339
+}
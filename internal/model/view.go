@@ -0,0 +1,15 @@
+package model
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// MovieViews is how many times a movie was served in the hour starting at
+// Hour.
+type MovieViews struct {
+	MovieID uuid.UUID
+	Hour    time.Time
+	Views   int
+}
@@ -0,0 +1,85 @@
+// Package pagination implements the opaque cursors used for keyset
+// pagination of discover and search results.
+package pagination
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// Sort keys for search results; discover cursors use "<field>.<asc|desc>".
+const (
+	SortSearch      = "search"
+	SortFuzzySearch = "search.fuzzy"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor identifies the last row of a page: its value in the sort column and
+// its id, which breaks ties so rows with equal sort values are never skipped
+// or repeated. Key ties it to the query and filters of the page it came from.
+type Cursor struct {
+	Sort  string  `json:"s"`
+	Value *string `json:"v,omitempty"` // nil when the sort column was NULL
+	ID    string  `json:"id"`
+	Key   string  `json:"k,omitempty"`
+}
+
+// QueryKey hashes the query and filters a cursor is issued for into its Key.
+// parts must be JSON-encodable.
+func QueryKey(parts ...any) string {
+	b, _ := json.Marshal(parts)
+	sum := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
+
+func Encode(c Cursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func Decode(s string) (Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil || c.Sort == "" {
+		return Cursor{}, ErrInvalidCursor
+	}
+	if _, err := uuid.FromString(c.ID); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// DecodeFor decodes s and checks that it was issued for the given sort, so a
+// cursor from one ordering can't be replayed against another.
+func DecodeFor(s, sort string) (Cursor, error) {
+	c, err := Decode(s)
+	if err != nil {
+		return Cursor{}, err
+	}
+	if c.Sort != sort {
+		return Cursor{}, fmt.Errorf("%w: issued for sort %q, not %q", ErrInvalidCursor, c.Sort, sort)
+	}
+	return c, nil
+}
+
+// DecodeForKey decodes s and checks that it was issued for the query and
+// filters key stands for, so a cursor can't continue a different search.
+func DecodeForKey(s, key string) (Cursor, error) {
+	c, err := Decode(s)
+	if err != nil {
+		return Cursor{}, err
+	}
+	if c.Key != key {
+		return Cursor{}, fmt.Errorf("%w: issued for other query parameters", ErrInvalidCursor)
+	}
+	return c, nil
+}
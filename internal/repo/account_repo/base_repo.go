@@ -0,0 +1,13 @@
+package accountrepo
+
+import (
+	"database/sql"
+)
+
+type Account_repo struct {
+	db *sql.DB
+}
+
+func New_Account_Repo(db *sql.DB) *Account_repo {
+	return &Account_repo{db: db}
+}
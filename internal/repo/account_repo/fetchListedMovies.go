@@ -0,0 +1,40 @@
+package accountrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	movierepo "github.com/h-raju-arch/movie_app_backend/internal/repo/movie_repo"
+)
+
+func (r Account_repo) FetchListedMovies(ctx context.Context, list List, userID uuid.UUID, lang string, page, pageSize int) ([]model.DiscoverItem, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	query := fmt.Sprintf(`
+    SELECT %s
+    FROM %s l
+    JOIN movies m ON m.id = l.movie_id
+    LEFT JOIN movie_translations mt ON mt.movie_id = m.id AND mt.language = $2
+    LEFT JOIN movie_stats ms ON ms.movie_id = m.id
+    WHERE l.user_id = $1
+    ORDER BY l.created_at DESC, m.id
+    LIMIT $3 OFFSET $4`, movierepo.DiscoverItemColumns, list)
+
+	rows, err := r.db.QueryContext(ctx, query, userID, lang, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error Query FetchListedMovies %s: %w", list, err)
+	}
+	items, totalCount, err := movierepo.ScanDiscoverItems(rows)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error Query FetchListedMovies %s: %w", list, err)
+	}
+	return items, totalCount, nil
+}
@@ -0,0 +1,30 @@
+package accountrepo
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// List is one of a user's movie lists; its value is the table it is
+// stored in.
+type List string
+
+const (
+	Watchlist List = "watchlist"
+	Favorites List = "favorites"
+)
+
+// AccountRepository stores users' watchlists and favorites. This interface
+// allows for easy mocking in unit tests.
+type AccountRepository interface {
+	// SetListed adds the movie to (on) or removes it from userID's list.
+	// Both are idempotent; unknown movies return an error wrapping
+	// sql.ErrNoRows.
+	SetListed(ctx context.Context, list List, userID uuid.UUID, movieID string, on bool) error
+
+	// FetchListedMovies returns one page of userID's list, most recently
+	// added first, and the total number of movies on it.
+	FetchListedMovies(ctx context.Context, list List, userID uuid.UUID, lang string, page, pageSize int) ([]model.DiscoverItem, int, error)
+}
@@ -0,0 +1,30 @@
+package accountrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r Account_repo) SetListed(ctx context.Context, list List, userID uuid.UUID, movieID string, on bool) error {
+	// the movie CTE tells an unknown movie apart from one that already is
+	// (or isn't) on the list
+	change := fmt.Sprintf(`DELETE FROM %s WHERE user_id = $1 AND movie_id = (SELECT id FROM movie)`, list)
+	if on {
+		change = fmt.Sprintf(`INSERT INTO %s (user_id, movie_id) SELECT $1, id FROM movie ON CONFLICT (user_id, movie_id) DO NOTHING`, list)
+	}
+	query := `WITH movie AS (SELECT id FROM movies WHERE id = $2),
+	          change AS (` + change + `)
+	          SELECT EXISTS (SELECT 1 FROM movie)`
+
+	var found bool
+	if err := r.db.QueryRowContext(ctx, query, userID, movieID).Scan(&found); err != nil {
+		return fmt.Errorf("Error Query SetListed %s: %w", list, err)
+	}
+	if !found {
+		return fmt.Errorf("Error Query SetListed %s: %w", list, sql.ErrNoRows)
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package companyrepo
+
+import "database/sql"
+
+type Company_repo struct {
+	db *sql.DB
+}
+
+func New_Company_Repo(db *sql.DB) *Company_repo {
+	return &Company_repo{db: db}
+}
@@ -0,0 +1,42 @@
+package companyrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	movierepo "github.com/h-raju-arch/movie_app_backend/internal/repo/movie_repo"
+)
+
+// FetchCompanyMovies returns one page of the movies a company produced,
+// newest first, and the total number of them.
+func (r *Company_repo) FetchCompanyMovies(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) ([]model.DiscoverItem, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	query := `
+    SELECT` + movierepo.DiscoverItemColumns + `
+    FROM movie_companies mc
+    JOIN movies m ON m.id = mc.movie_id
+    LEFT JOIN movie_translations mt ON mt.movie_id = m.id AND mt.language = $2
+    LEFT JOIN movie_stats ms ON ms.movie_id = m.id
+    WHERE mc.company_id = $1
+      AND ($3 OR m.adult = false)
+    ORDER BY m.release_date DESC NULLS LAST, m.id DESC
+    LIMIT $4 OFFSET $5`
+
+	rows, err := r.db.QueryContext(ctx, query, id, lang, includeAdult, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error Query FetchCompanyMovies: %w", err)
+	}
+	items, totalCount, err := movierepo.ScanDiscoverItems(rows)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error Query FetchCompanyMovies: %w", err)
+	}
+	return items, totalCount, nil
+}
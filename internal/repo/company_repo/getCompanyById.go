@@ -0,0 +1,22 @@
+package companyrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Company_repo) GetCompanyById(ctx context.Context, id string) (model.CompanyResponse, error) {
+	var res model.CompanyResponse
+
+	query := `SELECT c.id, c.name, c.origin_country, c.homepage
+	          FROM companies c
+			  WHERE c.id = $1;`
+
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&res.ID, &res.Name, &res.OriginCountry, &res.Homepage)
+	if err != nil {
+		return model.CompanyResponse{}, fmt.Errorf("Query company: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,14 @@
+package companyrepo
+
+import (
+	"context"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// CompanyRepository defines the data access operations for production
+// companies. This interface allows for easy mocking in unit tests.
+type CompanyRepository interface {
+	GetCompanyById(ctx context.Context, id string) (model.CompanyResponse, error)
+	FetchCompanyMovies(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) ([]model.DiscoverItem, int, error)
+}
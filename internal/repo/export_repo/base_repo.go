@@ -0,0 +1,11 @@
+package exportrepo
+
+import "database/sql"
+
+type Export_repo struct {
+	db *sql.DB
+}
+
+func New_Export_Repo(db *sql.DB) *Export_repo {
+	return &Export_repo{db: db}
+}
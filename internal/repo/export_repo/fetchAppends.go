@@ -0,0 +1,140 @@
+package exportrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/lib/pq"
+)
+
+// FetchAppends runs one query per kind of row for the whole batch instead
+// of one per movie. The ORDER BY clauses are those of the movie repo's
+// fetches, prefixed by the movie id.
+func (r Export_repo) FetchAppends(ctx context.Context, ids []uuid.UUID) (model.ExportAppends, error) {
+	var (
+		res model.ExportAppends
+		err error
+	)
+	movieIDs := make([]string, len(ids))
+	for i, id := range ids {
+		movieIDs[i] = id.String()
+	}
+	args := pq.Array(movieIDs)
+
+	res.Genres, err = collect(ctx, r.db, "Genres", `
+		SELECT mg.movie_id, g.id, g.name
+		FROM movie_genres mg JOIN genres g ON g.id = mg.genre_id
+		WHERE mg.movie_id = ANY($1::uuid[])
+		ORDER BY mg.movie_id, g.name`, args,
+		func(g *model.Genre) []any { return []any{&g.ID, &g.Name} })
+	if err != nil {
+		return res, err
+	}
+
+	res.Companies, err = collect(ctx, r.db, "Companies", `
+		SELECT mc.movie_id, c.id, c.name, c.origin_country
+		FROM movie_companies mc JOIN companies c ON c.id = mc.company_id
+		WHERE mc.movie_id = ANY($1::uuid[])
+		ORDER BY mc.movie_id, c.name`, args,
+		func(c *model.ProductionCompany) []any { return []any{&c.ID, &c.Name, &c.OriginCountry} })
+	if err != nil {
+		return res, err
+	}
+
+	res.Credits, err = collect(ctx, r.db, "Credits", `
+		SELECT c.movie_id, c.id, p.id, p.name, p.known_for, p.profile_path,
+		  c.credit_type, c.character_name, c.cast_order, c.department, c.job
+		FROM credits c JOIN people p ON p.id = c.person_id
+		WHERE c.movie_id = ANY($1::uuid[])
+		ORDER BY c.movie_id, c.credit_type, c.cast_order ASC NULLS LAST, c.department, c.job, p.name, c.id`, args,
+		func(c *model.MovieCredit) []any {
+			return []any{&c.CreditID, &c.ID, &c.Name, &c.KnownFor, &c.ProfilePath,
+				&c.CreditType, &c.Character, &c.CastOrder, &c.Department, &c.Job}
+		})
+	if err != nil {
+		return res, err
+	}
+
+	res.Images, err = collect(ctx, r.db, "Images", `
+		SELECT i.movie_id, i.type, COALESCE(i.file_path, ''), COALESCE(i.width, 0), COALESCE(i.height, 0), i.language
+		FROM images i
+		WHERE i.movie_id = ANY($1::uuid[])
+		ORDER BY i.movie_id, i.type, i.width DESC NULLS LAST, i.id`, args,
+		func(img *model.Image) []any {
+			return []any{&img.Type, &img.FilePath, &img.Width, &img.Height, &img.Language}
+		})
+	if err != nil {
+		return res, err
+	}
+	for _, images := range res.Images {
+		for i := range images {
+			if images[i].Height > 0 {
+				images[i].AspectRatio = float64(images[i].Width) / float64(images[i].Height)
+			}
+		}
+	}
+
+	res.Videos, err = collect(ctx, r.db, "Videos", `
+		SELECT v.movie_id, v.id, v.site, v.key, v.name, v.type, v.official, v.language,
+		  to_char(v.published_at AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"')
+		FROM videos v
+		WHERE v.movie_id = ANY($1::uuid[])
+		ORDER BY v.movie_id, v.official DESC, v.published_at DESC NULLS LAST, v.id`, args,
+		func(v *model.Video) []any {
+			return []any{&v.ID, &v.Site, &v.Key, &v.Name, &v.Type, &v.Official, &v.Language, &v.PublishedAt}
+		})
+	if err != nil {
+		return res, err
+	}
+
+	res.SpokenLanguages, err = collect(ctx, r.db, "SpokenLanguages", `
+		SELECT msl.movie_id, l.iso_639_1, COALESCE(l.name, ''), COALESCE(l.native_name, l.name, '')
+		FROM movie_spoken_languages msl JOIN languages l ON l.iso_639_1 = msl.iso_639_1
+		WHERE msl.movie_id = ANY($1::uuid[])
+		ORDER BY msl.movie_id, l.name`, args,
+		func(l *model.Language) []any { return []any{&l.ISO6391, &l.EnglishName, &l.Name} })
+	if err != nil {
+		return res, err
+	}
+
+	res.Translations, err = collect(ctx, r.db, "Translations", `
+		SELECT mt.movie_id, mt.language, l.name, COALESCE(l.native_name, l.name), mt.title, mt.overview
+		FROM movie_translations mt
+		LEFT JOIN languages l ON l.iso_639_1 = mt.language
+		WHERE mt.movie_id = ANY($1::uuid[])
+		ORDER BY mt.movie_id, mt.language`, args,
+		func(t *model.MovieTranslation) []any {
+			return []any{&t.ISO6391, &t.EnglishName, &t.Name, &t.Data.Title, &t.Data.Overview}
+		})
+	return res, err
+}
+
+// collect runs query and groups its rows by the movie id in the first
+// column; dest returns the scan targets for the remaining columns.
+func collect[T any](ctx context.Context, db *sql.DB, name, query string, ids any, dest func(v *T) []any) (map[uuid.UUID][]T, error) {
+	rows, err := db.QueryContext(ctx, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("Error Query Export%s: %w", name, err)
+	}
+	defer rows.Close()
+
+	res := make(map[uuid.UUID][]T)
+	for rows.Next() {
+		var (
+			movieID uuid.UUID
+			v       T
+		)
+		if err := rows.Scan(append([]any{&movieID}, dest(&v)...)...); err != nil {
+			return nil, fmt.Errorf("Error Export %s row scan: %w", name, err)
+		}
+		res[movieID] = append(res[movieID], v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Error Export %s row: %w", name, err)
+	}
+	return res, nil
+}
@@ -0,0 +1,23 @@
+package exportrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// ExportRepository reads the catalogue in batches for the export. This
+// interface allows for easy mocking in unit tests.
+type ExportRepository interface {
+	// ListMovies returns up to limit movies with an id after the given one,
+	// in id order, untranslated. A non-nil since keeps only movies updated
+	// at or after it.
+	ListMovies(ctx context.Context, since *time.Time, after uuid.UUID, limit int) ([]model.MovieResponse, error)
+
+	// FetchAppends loads the genres (in English), companies, credits,
+	// images, videos, spoken languages and translations of every movie in
+	// ids, ordered within each movie like the single-movie fetches.
+	FetchAppends(ctx context.Context, ids []uuid.UUID) (model.ExportAppends, error)
+}
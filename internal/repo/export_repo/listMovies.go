@@ -0,0 +1,46 @@
+package exportrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// ListMovies pages through movies by id, so every batch is an index range
+// scan whatever the size of the catalogue.
+func (r Export_repo) ListMovies(ctx context.Context, since *time.Time, after uuid.UUID, limit int) ([]model.MovieResponse, error) {
+	query := `SELECT m.id, m.title, m.overview, to_char(m.release_date, 'YYYY-MM-DD'),
+	            ms.vote_average, ms.vote_count,
+	            m.poster_path, m.backdrop_path, m.budget, m.revenue, m.homepage, m.updated_at
+	          FROM movies m
+	          LEFT JOIN movie_stats ms ON ms.movie_id = m.id
+	          WHERE m.id > $1
+	            AND ($2::timestamptz IS NULL OR m.updated_at >= $2)
+	          ORDER BY m.id
+	          LIMIT $3`
+
+	rows, err := r.db.QueryContext(ctx, query, after, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("Error Query ListMovies: %w", err)
+	}
+	defer rows.Close()
+
+	var res []model.MovieResponse
+	for rows.Next() {
+		var m model.MovieResponse
+		err := rows.Scan(&m.ID, &m.Title, &m.Overview, &m.ReleaseDate, &m.VoteAverage, &m.VoteCount,
+			&m.PosterPath, &m.BackdropPath, &m.Budget, &m.Revenue, &m.Homepage, &m.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("Error List Movies row scan: %w", err)
+		}
+		res = append(res, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Error List Movies row: %w", err)
+	}
+	return res, nil
+}
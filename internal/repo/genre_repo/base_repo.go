@@ -0,0 +1,11 @@
+package genrerepo
+
+import "database/sql"
+
+type Genre_repo struct {
+	db *sql.DB
+}
+
+func New_Genre_Repo(db *sql.DB) *Genre_repo {
+	return &Genre_repo{db: db}
+}
@@ -0,0 +1,13 @@
+package genrerepo
+
+import (
+	"context"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// GenreRepository defines the data access operations for the genre
+// catalogue. This interface allows for easy mocking in unit tests.
+type GenreRepository interface {
+	ListGenres(ctx context.Context, lang string) ([]model.Genre, error)
+}
@@ -0,0 +1,37 @@
+package genrerepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// ListGenres returns every genre named in lang, falling back to the English
+// name, ordered by the localized name.
+func (r *Genre_repo) ListGenres(ctx context.Context, lang string) ([]model.Genre, error) {
+	query := `SELECT g.id, COALESCE(gt.name, g.name) AS name
+	          FROM genres g
+	          LEFT JOIN genre_translations gt ON gt.genre_id = g.id AND gt.language = $1
+	          ORDER BY name, g.id`
+
+	rows, err := r.db.QueryContext(ctx, query, lang)
+	if err != nil {
+		return nil, fmt.Errorf("Error Query ListGenres: %w", err)
+	}
+	defer rows.Close()
+
+	res := []model.Genre{}
+	for rows.Next() {
+		var g model.Genre
+		if err := rows.Scan(&g.ID, &g.Name); err != nil {
+			return nil, fmt.Errorf("Error List Genres row scan: %w", err)
+		}
+		res = append(res, g)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Error List Genres row: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,11 @@
+package importrepo
+
+import "database/sql"
+
+type Import_repo struct {
+	db *sql.DB
+}
+
+func New_Import_Repo(db *sql.DB) *Import_repo {
+	return &Import_repo{db: db}
+}
@@ -0,0 +1,23 @@
+package importrepo
+
+import (
+	"context"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// ImportRepository writes imported movies. This interface allows for easy
+// mocking in unit tests.
+type ImportRepository interface {
+	// UpsertMovies writes a batch of movies in one transaction, with one
+	// statement per table, matching existing movies by external_id, which
+	// must not repeat within the batch. Genres, companies and people are
+	// matched by name and created when missing. An updated movie's genres,
+	// companies, spoken languages, credits, images and translations are
+	// replaced by the imported ones; its vote_average and vote_count, which
+	// follow its ratings, are kept.
+	//
+	// It reports, for each movie, whether it was inserted rather than
+	// updated.
+	UpsertMovies(ctx context.Context, movies []model.ImportMovie) ([]bool, error)
+}
@@ -0,0 +1,124 @@
+package importrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/lib/pq"
+)
+
+// resolveNames maps each name to the id of a genre or company (table) with
+// that name, inserting the ones that don't exist. Names aren't unique, so
+// the row with the lowest id wins.
+func resolveNames(ctx context.Context, tx *sql.Tx, table string, names []string) (map[string]uuid.UUID, error) {
+	ids, err := lookupNames(ctx, tx, table, names)
+	if err != nil {
+		return nil, err
+	}
+
+	var newIDs, newNames []string
+	for _, name := range names {
+		if _, ok := ids[name]; ok {
+			continue
+		}
+		id := uuid.Must(uuid.NewV7())
+		ids[name] = id
+		newIDs, newNames = append(newIDs, id.String()), append(newNames, name)
+	}
+	if len(newIDs) == 0 {
+		return ids, nil
+	}
+
+	query := `INSERT INTO ` + table + ` (id, name) SELECT unnest($1::uuid[]), unnest($2::text[])`
+	if _, err := tx.ExecContext(ctx, query, pq.Array(newIDs), pq.Array(newNames)); err != nil {
+		return nil, fmt.Errorf("Error Query insert %s: %w", table, err)
+	}
+	return ids, nil
+}
+
+// resolvePeople is resolveNames for the people credited in movies; new people
+// get the known_for and profile_path of their first credit.
+func resolvePeople(ctx context.Context, tx *sql.Tx, movies []model.ImportMovie) (map[string]uuid.UUID, error) {
+	var names []string
+	first := make(map[string]model.ImportCredit)
+	for _, m := range movies {
+		for _, c := range m.Credits {
+			if _, ok := first[c.Name]; !ok {
+				first[c.Name] = c
+				names = append(names, c.Name)
+			}
+		}
+	}
+
+	ids, err := lookupNames(ctx, tx, "people", names)
+	if err != nil {
+		return nil, err
+	}
+
+	var newIDs, newNames []string
+	var knownFor, profilePaths []sql.NullString
+	for _, name := range names {
+		if _, ok := ids[name]; ok {
+			continue
+		}
+		id := uuid.Must(uuid.NewV7())
+		ids[name] = id
+		c := first[name]
+		newIDs, newNames = append(newIDs, id.String()), append(newNames, name)
+		knownFor, profilePaths = append(knownFor, nullString(c.KnownFor)), append(profilePaths, nullString(c.ProfilePath))
+	}
+	if len(newIDs) == 0 {
+		return ids, nil
+	}
+
+	query := `INSERT INTO people (id, name, known_for, profile_path)
+	          SELECT unnest($1::uuid[]), unnest($2::text[]), unnest($3::text[]), unnest($4::text[])`
+	if _, err := tx.ExecContext(ctx, query, pq.Array(newIDs), pq.Array(newNames), pq.Array(knownFor), pq.Array(profilePaths)); err != nil {
+		return nil, fmt.Errorf("Error Query insert people: %w", err)
+	}
+	return ids, nil
+}
+
+func lookupNames(ctx context.Context, tx *sql.Tx, table string, names []string) (map[string]uuid.UUID, error) {
+	ids := make(map[string]uuid.UUID)
+	if len(names) == 0 {
+		return ids, nil
+	}
+
+	query := `SELECT DISTINCT ON (name) name, id FROM ` + table + ` WHERE name = ANY($1) ORDER BY name, id`
+	rows, err := tx.QueryContext(ctx, query, pq.Array(names))
+	if err != nil {
+		return nil, fmt.Errorf("Error Query lookup %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		var id uuid.UUID
+		if err := rows.Scan(&name, &id); err != nil {
+			return nil, fmt.Errorf("Error lookup %s row scan: %w", table, err)
+		}
+		ids[name] = id
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Error lookup %s row: %w", table, err)
+	}
+	return ids, nil
+}
+
+func nullString(s *string) sql.NullString {
+	if s == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *s, Valid: true}
+}
+
+func nullInt(n *int) sql.NullInt64 {
+	if n == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(*n), Valid: true}
+}
@@ -0,0 +1,322 @@
+package importrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/lib/pq"
+)
+
+func (r *Import_repo) UpsertMovies(ctx context.Context, movies []model.ImportMovie) ([]bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	var genreNames, companyNames []string
+	for _, m := range movies {
+		genreNames = append(genreNames, m.Genres...)
+		companyNames = append(companyNames, m.Companies...)
+	}
+	genres, err := resolveNames(ctx, tx, "genres", unique(genreNames))
+	if err != nil {
+		return nil, err
+	}
+	companies, err := resolveNames(ctx, tx, "companies", unique(companyNames))
+	if err != nil {
+		return nil, err
+	}
+	people, err := resolvePeople(ctx, tx, movies)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, inserted, err := upsertMovieRows(ctx, tx, movies)
+	if err != nil {
+		return nil, err
+	}
+	if err := upsertStats(ctx, tx, movies, ids); err != nil {
+		return nil, err
+	}
+
+	// an updated movie's child rows are all replaced
+	var updated []string
+	for i, ins := range inserted {
+		if !ins {
+			updated = append(updated, ids[i])
+		}
+	}
+	if len(updated) > 0 {
+		for _, table := range []string{"movie_genres", "movie_companies", "movie_spoken_languages", "credits", "images", "movie_translations"} {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE movie_id = ANY($1::uuid[])`, pq.Array(updated)); err != nil {
+				return nil, fmt.Errorf("Error Query clear %s: %w", table, err)
+			}
+		}
+	}
+
+	if err := insertLinks(ctx, tx, movies, ids, genres, companies); err != nil {
+		return nil, err
+	}
+	if err := insertCredits(ctx, tx, movies, ids, people); err != nil {
+		return nil, err
+	}
+	if err := insertImages(ctx, tx, movies, ids); err != nil {
+		return nil, err
+	}
+	if err := insertTranslations(ctx, tx, movies, ids); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("Error commit tx: %w", err)
+	}
+	return inserted, nil
+}
+
+// upsertMovieRows writes the movies rows in one statement and returns each
+// movie's id and whether it was inserted, in the order of movies.
+func upsertMovieRows(ctx context.Context, tx *sql.Tx, movies []model.ImportMovie) ([]string, []bool, error) {
+	var newIDs, externalIDs, titles []string
+	var originalTitles, originalLanguages, tagLines, overviews, releaseDates []sql.NullString
+	var homepages, posterPaths, backdropPaths []sql.NullString
+	var runtimes []sql.NullInt64
+	var adults []bool
+	var budgets, revenues []int64
+	for _, m := range movies {
+		newIDs = append(newIDs, uuid.Must(uuid.NewV7()).String())
+		externalIDs = append(externalIDs, m.ExternalID)
+		titles = append(titles, m.Title)
+		originalTitles = append(originalTitles, nullString(m.OriginalTitle))
+		originalLanguages = append(originalLanguages, nullString(m.OriginalLanguage))
+		tagLines = append(tagLines, nullString(m.TagLine))
+		overviews = append(overviews, nullString(m.Overview))
+		releaseDates = append(releaseDates, nullString(m.ReleaseDate))
+		runtimes = append(runtimes, nullInt(m.Runtime))
+		adults = append(adults, m.Adult)
+		homepages = append(homepages, nullString(m.Homepage))
+		posterPaths = append(posterPaths, nullString(m.PosterPath))
+		backdropPaths = append(backdropPaths, nullString(m.BackdropPath))
+		budgets = append(budgets, m.Budget)
+		revenues = append(revenues, m.Revenue)
+	}
+
+	// xmax is only set on rows the ON CONFLICT branch updated. A batch must
+	// not repeat an external_id, or the second copy fails the statement.
+	query := `INSERT INTO movies (
+	            id, external_id, title, original_title, original_language, tag_line, overview,
+	            release_date, runtime, adult, homepage, poster_path, backdrop_path, budget, revenue
+	          )
+	          SELECT * FROM unnest($1::uuid[], $2::text[], $3::text[], $4::text[], $5::text[], $6::text[], $7::text[],
+	                               $8::date[], $9::int[], $10::bool[], $11::text[], $12::text[], $13::text[],
+	                               $14::bigint[], $15::bigint[])
+	          ON CONFLICT (external_id) DO UPDATE SET
+	            title = EXCLUDED.title, original_title = EXCLUDED.original_title,
+	            original_language = EXCLUDED.original_language, tag_line = EXCLUDED.tag_line,
+	            overview = EXCLUDED.overview, release_date = EXCLUDED.release_date,
+	            runtime = EXCLUDED.runtime, adult = EXCLUDED.adult, homepage = EXCLUDED.homepage,
+	            poster_path = EXCLUDED.poster_path, backdrop_path = EXCLUDED.backdrop_path,
+	            budget = EXCLUDED.budget, revenue = EXCLUDED.revenue, updated_at = now()
+	          RETURNING external_id, id, xmax = 0`
+
+	rows, err := tx.QueryContext(ctx, query, pq.Array(newIDs), pq.Array(externalIDs), pq.Array(titles),
+		pq.Array(originalTitles), pq.Array(originalLanguages), pq.Array(tagLines), pq.Array(overviews),
+		pq.Array(releaseDates), pq.Array(runtimes), pq.Array(adults), pq.Array(homepages),
+		pq.Array(posterPaths), pq.Array(backdropPaths), pq.Array(budgets), pq.Array(revenues))
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error Query upsert movies: %w", err)
+	}
+	defer rows.Close()
+
+	type written struct {
+		id       string
+		inserted bool
+	}
+	byExternalID := make(map[string]written, len(movies))
+	for rows.Next() {
+		var externalID string
+		var w written
+		if err := rows.Scan(&externalID, &w.id, &w.inserted); err != nil {
+			return nil, nil, fmt.Errorf("Error upsert movies row scan: %w", err)
+		}
+		byExternalID[externalID] = w
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("Error upsert movies row: %w", err)
+	}
+
+	ids := make([]string, len(movies))
+	inserted := make([]bool, len(movies))
+	for i, m := range movies {
+		w, ok := byExternalID[m.ExternalID]
+		if !ok {
+			return nil, nil, fmt.Errorf("Error upsert movies: movie %s was not returned", m.ExternalID)
+		}
+		ids[i], inserted[i] = w.id, w.inserted
+	}
+	return ids, inserted, nil
+}
+
+func upsertStats(ctx context.Context, tx *sql.Tx, movies []model.ImportMovie, ids []string) error {
+	var popularities, voteAverages []float64
+	var voteCounts []int64
+	for _, m := range movies {
+		popularities = append(popularities, m.Popularity)
+		voteAverages = append(voteAverages, m.VoteAverage)
+		voteCounts = append(voteCounts, int64(m.VoteCount))
+	}
+
+	// the imported votes only seed a new movie; after that the vote columns
+	// follow its ratings
+	query := `INSERT INTO movie_stats (movie_id, popularity, base_popularity, vote_average, vote_count)
+	          SELECT s.movie_id, s.popularity, s.popularity, s.vote_average, s.vote_count
+	          FROM unnest($1::uuid[], $2::float8[], $3::float8[], $4::int[]) AS s(movie_id, popularity, vote_average, vote_count)
+	          ON CONFLICT (movie_id) DO UPDATE SET
+	            popularity = EXCLUDED.popularity, base_popularity = EXCLUDED.base_popularity`
+	_, err := tx.ExecContext(ctx, query, pq.Array(ids), pq.Array(popularities), pq.Array(voteAverages), pq.Array(voteCounts))
+	if err != nil {
+		return fmt.Errorf("Error Query upsert movie_stats: %w", err)
+	}
+	return nil
+}
+
+// insertLinks writes the genre, company and spoken language links of every
+// movie, one statement per table.
+func insertLinks(ctx context.Context, tx *sql.Tx, movies []model.ImportMovie, ids []string, genres, companies map[string]uuid.UUID) error {
+	links := []struct {
+		table, column, typ string
+		movieIDs, values   []string
+	}{
+		{table: "movie_genres", column: "genre_id", typ: "uuid"},
+		{table: "movie_companies", column: "company_id", typ: "uuid"},
+		{table: "movie_spoken_languages", column: "iso_639_1", typ: "text"},
+	}
+	for i, m := range movies {
+		for j, values := range [][]string{idsOf(genres, m.Genres), idsOf(companies, m.Companies), m.SpokenLanguages} {
+			for _, v := range values {
+				links[j].movieIDs = append(links[j].movieIDs, ids[i])
+				links[j].values = append(links[j].values, v)
+			}
+		}
+	}
+
+	for _, l := range links {
+		if len(l.values) == 0 {
+			continue
+		}
+		query := `INSERT INTO ` + l.table + ` (movie_id, ` + l.column + `)
+		          SELECT unnest($1::uuid[]), unnest($2::text[])::` + l.typ + ` ON CONFLICT DO NOTHING`
+		if _, err := tx.ExecContext(ctx, query, pq.Array(l.movieIDs), pq.Array(l.values)); err != nil {
+			return fmt.Errorf("Error Query insert %s: %w", l.table, err)
+		}
+	}
+	return nil
+}
+
+func insertCredits(ctx context.Context, tx *sql.Tx, movies []model.ImportMovie, movieIDs []string, people map[string]uuid.UUID) error {
+	var ids, creditMovieIDs, personIDs, types []string
+	var characters, departments, jobs []sql.NullString
+	var orders []sql.NullInt64
+	for i, m := range movies {
+		for _, c := range m.Credits {
+			ids = append(ids, uuid.Must(uuid.NewV7()).String())
+			creditMovieIDs = append(creditMovieIDs, movieIDs[i])
+			personIDs = append(personIDs, people[c.Name].String())
+			types = append(types, c.CreditType)
+			characters = append(characters, nullString(c.Character))
+			orders = append(orders, nullInt(c.CastOrder))
+			departments = append(departments, nullString(c.Department))
+			jobs = append(jobs, nullString(c.Job))
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO credits (id, movie_id, person_id, credit_type, character_name, cast_order, department, job)
+	          SELECT unnest($1::uuid[]), unnest($2::uuid[]), unnest($3::uuid[]), unnest($4::text[]), unnest($5::text[]),
+	                 unnest($6::int[]), unnest($7::text[]), unnest($8::text[])`
+	_, err := tx.ExecContext(ctx, query, pq.Array(ids), pq.Array(creditMovieIDs), pq.Array(personIDs), pq.Array(types),
+		pq.Array(characters), pq.Array(orders), pq.Array(departments), pq.Array(jobs))
+	if err != nil {
+		return fmt.Errorf("Error Query insert credits: %w", err)
+	}
+	return nil
+}
+
+func insertImages(ctx context.Context, tx *sql.Tx, movies []model.ImportMovie, movieIDs []string) error {
+	var ids, imageMovieIDs, paths, types []string
+	var widths, heights []int64
+	var languages []sql.NullString
+	for i, m := range movies {
+		for _, img := range m.Images {
+			ids = append(ids, uuid.Must(uuid.NewV7()).String())
+			imageMovieIDs = append(imageMovieIDs, movieIDs[i])
+			paths = append(paths, img.FilePath)
+			types = append(types, img.Type)
+			widths = append(widths, int64(img.Width))
+			heights = append(heights, int64(img.Height))
+			languages = append(languages, nullString(img.Language))
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO images (id, movie_id, file_path, type, width, height, language)
+	          SELECT unnest($1::uuid[]), unnest($2::uuid[]), unnest($3::text[]), unnest($4::image_type[]),
+	                 unnest($5::int[]), unnest($6::int[]), unnest($7::text[])`
+	_, err := tx.ExecContext(ctx, query, pq.Array(ids), pq.Array(imageMovieIDs), pq.Array(paths), pq.Array(types),
+		pq.Array(widths), pq.Array(heights), pq.Array(languages))
+	if err != nil {
+		return fmt.Errorf("Error Query insert images: %w", err)
+	}
+	return nil
+}
+
+func insertTranslations(ctx context.Context, tx *sql.Tx, movies []model.ImportMovie, movieIDs []string) error {
+	var translationMovieIDs, languages []string
+	var titles, overviews []sql.NullString
+	for i, m := range movies {
+		for _, t := range m.Translations {
+			translationMovieIDs = append(translationMovieIDs, movieIDs[i])
+			languages = append(languages, t.Language)
+			titles = append(titles, nullString(t.Title))
+			overviews = append(overviews, nullString(t.Overview))
+		}
+	}
+	if len(languages) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO movie_translations (movie_id, language, title, overview)
+	          SELECT unnest($1::uuid[]), unnest($2::text[]), unnest($3::text[]), unnest($4::text[])`
+	_, err := tx.ExecContext(ctx, query, pq.Array(translationMovieIDs), pq.Array(languages), pq.Array(titles), pq.Array(overviews))
+	if err != nil {
+		return fmt.Errorf("Error Query insert translations: %w", err)
+	}
+	return nil
+}
+
+func idsOf(ids map[string]uuid.UUID, names []string) []string {
+	res := make([]string, 0, len(names))
+	for _, name := range names {
+		res = append(res, ids[name].String())
+	}
+	return res
+}
+
+func unique(names []string) []string {
+	seen := make(map[string]bool)
+	var res []string
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			res = append(res, name)
+		}
+	}
+	return res
+}
@@ -0,0 +1,11 @@
+package languagerepo
+
+import "database/sql"
+
+type Language_repo struct {
+	db *sql.DB
+}
+
+func New_Language_Repo(db *sql.DB) *Language_repo {
+	return &Language_repo{db: db}
+}
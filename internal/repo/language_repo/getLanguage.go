@@ -0,0 +1,22 @@
+package languagerepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// GetLanguage looks a language up by its iso_639_1 code. Unknown codes
+// return an error wrapping sql.ErrNoRows.
+func (r *Language_repo) GetLanguage(ctx context.Context, iso string) (model.Language, error) {
+	query := `SELECT l.iso_639_1, COALESCE(l.name, ''), COALESCE(l.native_name, l.name, '')
+	          FROM languages l
+	          WHERE l.iso_639_1 = $1`
+
+	var l model.Language
+	if err := r.db.QueryRowContext(ctx, query, iso).Scan(&l.ISO6391, &l.EnglishName, &l.Name); err != nil {
+		return model.Language{}, fmt.Errorf("Error Query GetLanguage: %w", err)
+	}
+	return l, nil
+}
@@ -0,0 +1,14 @@
+package languagerepo
+
+import (
+	"context"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// LanguageRepository defines the data access operations for the languages
+// table. This interface allows for easy mocking in unit tests.
+type LanguageRepository interface {
+	ListLanguages(ctx context.Context) ([]model.Language, error)
+	GetLanguage(ctx context.Context, iso string) (model.Language, error)
+}
@@ -0,0 +1,34 @@
+package languagerepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Language_repo) ListLanguages(ctx context.Context) ([]model.Language, error) {
+	query := `SELECT l.iso_639_1, COALESCE(l.name, ''), COALESCE(l.native_name, l.name, '')
+	          FROM languages l
+	          ORDER BY l.name, l.iso_639_1`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("Error Query ListLanguages: %w", err)
+	}
+	defer rows.Close()
+
+	res := []model.Language{}
+	for rows.Next() {
+		var l model.Language
+		if err := rows.Scan(&l.ISO6391, &l.EnglishName, &l.Name); err != nil {
+			return nil, fmt.Errorf("Error List Languages row scan: %w", err)
+		}
+		res = append(res, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Error List Languages row: %w", err)
+	}
+	return res, nil
+}
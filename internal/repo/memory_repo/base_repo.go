@@ -0,0 +1,177 @@
+package memoryrepo
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	accountrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/account_repo"
+	companyrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/company_repo"
+	exportrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/export_repo"
+	genrerepo "github.com/h-raju-arch/movie_app_backend/internal/repo/genre_repo"
+	importrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/import_repo"
+	languagerepo "github.com/h-raju-arch/movie_app_backend/internal/repo/language_repo"
+	movierepo "github.com/h-raju-arch/movie_app_backend/internal/repo/movie_repo"
+	personrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/person_repo"
+	ratingrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/rating_repo"
+	reviewrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/review_repo"
+	userrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/user_repo"
+	viewrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/view_repo"
+)
+
+var (
+	_ movierepo.MovieRepository       = (*Memory_repo)(nil)
+	_ movierepo.MovieWriter           = (*Memory_repo)(nil)
+	_ personrepo.PersonRepository     = (*Memory_repo)(nil)
+	_ companyrepo.CompanyRepository   = (*Memory_repo)(nil)
+	_ genrerepo.GenreRepository       = (*Memory_repo)(nil)
+	_ languagerepo.LanguageRepository = (*Memory_repo)(nil)
+	_ importrepo.ImportRepository     = (*Memory_repo)(nil)
+	_ exportrepo.ExportRepository     = (*Memory_repo)(nil)
+	_ userrepo.UserRepository         = (*Memory_repo)(nil)
+	_ ratingrepo.RatingRepository     = (*Memory_repo)(nil)
+	_ accountrepo.AccountRepository   = (*Memory_repo)(nil)
+	_ reviewrepo.ReviewRepository     = (*Memory_repo)(nil)
+	_ viewrepo.ViewRepository         = (*Memory_repo)(nil)
+)
+
+// Memory_repo implements the repository interfaces entirely in memory, for
+// running the API without PostgreSQL and for service tests.
+type Memory_repo struct {
+	mu sync.RWMutex
+
+	languages         []fixtures.Language
+	genres            map[uuid.UUID]fixtures.Genre
+	genreTranslations map[uuid.UUID]map[string]string // by genre id, then language
+	companies         map[uuid.UUID]fixtures.Company
+	people            map[uuid.UUID]fixtures.Person
+	movies            map[uuid.UUID]fixtures.Movie
+	movieOrder        []uuid.UUID                                   // insertion order, for stable iteration
+	externalIDs       map[string]uuid.UUID                          // movie id by external_id
+	credits           map[uuid.UUID][]fixtures.Credit               // by movie id
+	images            map[uuid.UUID][]fixtures.Image                // by movie id
+	videos            map[uuid.UUID][]fixtures.Video                // by movie id
+	translations      map[uuid.UUID]map[string]fixtures.Translation // by movie id, then language
+	users             map[uuid.UUID]model.User
+	userEmails        map[string]uuid.UUID                                       // user id by email
+	ratings           map[uuid.UUID]map[uuid.UUID]rating                         // by user id, then movie id
+	reviews           map[uuid.UUID]model.Review                                 // by review id
+	lists             map[accountrepo.List]map[uuid.UUID]map[uuid.UUID]time.Time // created_at by list, user id, then movie id
+	views             map[uuid.UUID]map[time.Time]int                            // view counts by movie id, then hour
+}
+
+func New_Memory_Repo(ds *fixtures.Dataset) *Memory_repo {
+	r := &Memory_repo{
+		genres:            make(map[uuid.UUID]fixtures.Genre),
+		genreTranslations: make(map[uuid.UUID]map[string]string),
+		companies:         make(map[uuid.UUID]fixtures.Company),
+		people:            make(map[uuid.UUID]fixtures.Person),
+		movies:            make(map[uuid.UUID]fixtures.Movie),
+		externalIDs:       make(map[string]uuid.UUID),
+		credits:           make(map[uuid.UUID][]fixtures.Credit),
+		images:            make(map[uuid.UUID][]fixtures.Image),
+		videos:            make(map[uuid.UUID][]fixtures.Video),
+		translations:      make(map[uuid.UUID]map[string]fixtures.Translation),
+		users:             make(map[uuid.UUID]model.User),
+		userEmails:        make(map[string]uuid.UUID),
+		ratings:           make(map[uuid.UUID]map[uuid.UUID]rating),
+		reviews:           make(map[uuid.UUID]model.Review),
+		lists:             make(map[accountrepo.List]map[uuid.UUID]map[uuid.UUID]time.Time),
+		views:             make(map[uuid.UUID]map[time.Time]int),
+	}
+	if ds != nil {
+		r.Load(ds)
+	}
+	return r
+}
+
+// Load adds every row of ds to the store, replacing rows with the same id.
+func (r *Memory_repo) Load(ds *fixtures.Dataset) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, l := range ds.Languages {
+		i := slices.IndexFunc(r.languages, func(have fixtures.Language) bool { return have.ISO == l.ISO })
+		if i >= 0 {
+			r.languages[i] = l
+		} else {
+			r.languages = append(r.languages, l)
+		}
+	}
+	for _, g := range ds.Genres {
+		r.genres[g.ID] = g
+	}
+	for _, t := range ds.GenreTranslations {
+		if r.genreTranslations[t.GenreID] == nil {
+			r.genreTranslations[t.GenreID] = make(map[string]string)
+		}
+		r.genreTranslations[t.GenreID][t.Language] = t.Name
+	}
+	for _, c := range ds.Companies {
+		r.companies[c.ID] = c
+	}
+	for _, p := range ds.People {
+		r.people[p.ID] = p
+	}
+	for _, m := range ds.Movies {
+		if _, ok := r.movies[m.ID]; !ok {
+			r.movieOrder = append(r.movieOrder, m.ID)
+		}
+		r.movies[m.ID] = m
+		if m.ExternalID != "" {
+			r.externalIDs[m.ExternalID] = m.ID
+		}
+	}
+	for _, c := range ds.Credits {
+		r.credits[c.MovieID] = append(r.credits[c.MovieID], c)
+	}
+	for _, img := range ds.Images {
+		r.images[img.MovieID] = append(r.images[img.MovieID], img)
+	}
+	for _, v := range ds.Videos {
+		r.videos[v.MovieID] = append(r.videos[v.MovieID], v)
+	}
+	for _, t := range ds.Translations {
+		if r.translations[t.MovieID] == nil {
+			r.translations[t.MovieID] = make(map[string]fixtures.Translation)
+		}
+		r.translations[t.MovieID][t.Language] = t
+	}
+}
+
+// localized mirrors COALESCE(mt.title, m.title) / COALESCE(mt.overview, m.overview).
+func (r *Memory_repo) localized(m fixtures.Movie, lang string) (string, string) {
+	title, overview := m.Title, m.Overview
+	if t, ok := r.translations[m.ID][lang]; ok {
+		if t.Title != "" {
+			title = t.Title
+		}
+		if t.Overview != "" {
+			overview = t.Overview
+		}
+	}
+	return title, overview
+}
+
+func parseID(id string) (uuid.UUID, error) {
+	u, err := uuid.FromString(id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid uuid %q: %w", id, err)
+	}
+	return u, nil
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,29 @@
+package memoryrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) CreateMovie(ctx context.Context, id uuid.UUID, w model.MovieWrite) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.movies[id]; ok {
+		return fmt.Errorf("Error Query CreateMovie: movie %s already exists", id)
+	}
+	if err := r.checkMovieLinks(w); err != nil {
+		return err
+	}
+
+	m := fixtures.Movie{ID: id, CreatedAt: time.Now()}
+	applyMovieWrite(&m, w, true)
+	r.movies[id] = m
+	r.movieOrder = append(r.movieOrder, id)
+	return nil
+}
@@ -0,0 +1,49 @@
+package memoryrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+func (r *Memory_repo) DeleteMovie(ctx context.Context, id string) error {
+	movieID, err := parseID(id)
+	if err != nil {
+		return fmt.Errorf("Error Query DeleteMovie: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m, ok := r.movies[movieID]
+	if !ok {
+		return fmt.Errorf("Error Query DeleteMovie: %w", sql.ErrNoRows)
+	}
+
+	// ON DELETE CASCADE
+	delete(r.movies, movieID)
+	delete(r.externalIDs, m.ExternalID)
+	delete(r.credits, movieID)
+	delete(r.images, movieID)
+	delete(r.videos, movieID)
+	delete(r.translations, movieID)
+	for _, rated := range r.ratings {
+		delete(rated, movieID)
+	}
+	for id, rv := range r.reviews {
+		if rv.MovieID == movieID {
+			delete(r.reviews, id)
+		}
+	}
+	for _, users := range r.lists {
+		for _, listed := range users {
+			delete(listed, movieID)
+		}
+	}
+	delete(r.views, movieID)
+	r.movieOrder = slices.DeleteFunc(r.movieOrder, func(have uuid.UUID) bool { return have == movieID })
+	return nil
+}
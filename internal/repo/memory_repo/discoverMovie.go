@@ -0,0 +1,208 @@
+package memoryrepo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/h-raju-arch/movie_app_backend/internal/pagination"
+)
+
+func (r *Memory_repo) DiscoverMovies(ctx context.Context, p model.DiscoverMoviesParams) ([]model.DiscoverItem, int, error) {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.PageSize <= 0 || p.PageSize > 100 {
+		p.PageSize = 20
+	}
+	offset := (p.Page - 1) * p.PageSize
+
+	field, dir := p.SortKey()
+	var after *sortKey
+	if p.Cursor != "" {
+		key, err := cursorKey(p.Cursor, field, dir)
+		if err != nil {
+			return nil, 0, fmt.Errorf("Discover movies cursor: %w", err)
+		}
+		after = &key
+		offset = 0
+	}
+
+	genreIDs := make([]uuid.UUID, 0, len(p.WithGenres))
+	for _, g := range p.WithGenres {
+		gid, err := parseID(g)
+		if err != nil {
+			return nil, 0, fmt.Errorf("Error Querying Discover movies: %w", err)
+		}
+		genreIDs = append(genreIDs, gid)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matches []fixtures.Movie
+	for _, id := range r.movieOrder {
+		m := r.movies[id]
+
+		if !p.IncludeAdult && m.Adult {
+			continue
+		}
+		// release dates are YYYY-MM-DD, so string order is date order;
+		// a missing date never satisfies a comparison, like NULL in SQL
+		if p.ReleaseDateGTE != nil && (m.ReleaseDate == "" || m.ReleaseDate < *p.ReleaseDateGTE) {
+			continue
+		}
+		if p.ReleaseDateLTE != nil && (m.ReleaseDate == "" || m.ReleaseDate > *p.ReleaseDateLTE) {
+			continue
+		}
+		if p.VoteAvgGTE != nil && m.VoteAverage < *p.VoteAvgGTE {
+			continue
+		}
+		if p.VoteAvgLTE != nil && m.VoteAverage > *p.VoteAvgLTE {
+			continue
+		}
+		if len(genreIDs) > 0 && !matchesGenres(m, genreIDs, p.WithGenresAND) {
+			continue
+		}
+		if after != nil && compareKeys(movieKey(m, field), *after, dir) <= 0 {
+			continue
+		}
+		matches = append(matches, m)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return compareKeys(movieKey(matches[i], field), movieKey(matches[j], field), dir) < 0
+	})
+
+	var items []model.DiscoverItem
+	for _, m := range paginate(matches, offset, p.PageSize+1) {
+		items = append(items, r.discoverItem(m, p.Language))
+	}
+
+	// the SQL query skips the count in cursor mode
+	if after != nil {
+		return items, 0, nil
+	}
+	return items, len(matches), nil
+}
+
+// discoverItem is the DiscoverItem for m with its title in lang.
+func (r *Memory_repo) discoverItem(m fixtures.Movie, lang string) model.DiscoverItem {
+	title, overview := r.localized(m, lang)
+	it := model.DiscoverItem{
+		ID:           m.ID,
+		Title:        title,
+		Overview:     ptr(overview),
+		ReleaseDate:  optional(m.ReleaseDate),
+		VoteAverage:  ptr(m.VoteAverage),
+		VoteCount:    ptr(m.VoteCount),
+		PosterPath:   ptr(m.PosterPath),
+		BackdropPath: ptr(m.BackdropPath),
+		Popularity:   ptr(m.Popularity),
+		GenreIDs:     []string{},
+	}
+	for _, gid := range m.GenreIDs {
+		it.GenreIDs = append(it.GenreIDs, gid.String())
+	}
+	return it
+}
+
+// matchesGenres mirrors the SQL filters: with AND every requested genre must
+// be present, with OR any one of them is enough.
+func matchesGenres(m fixtures.Movie, want []uuid.UUID, all bool) bool {
+	have := make(map[uuid.UUID]bool, len(m.GenreIDs))
+	for _, gid := range m.GenreIDs {
+		have[gid] = true
+	}
+	matched := make(map[uuid.UUID]bool)
+	for _, gid := range want {
+		if have[gid] {
+			matched[gid] = true
+		}
+	}
+	if all {
+		return len(matched) == len(want)
+	}
+	return len(matched) > 0
+}
+
+// sortKey is a row's position in a discover ordering: the sort column value
+// (nil for NULL) and the id that breaks ties.
+type sortKey struct {
+	value *string
+	id    string
+}
+
+func movieKey(m fixtures.Movie, field string) sortKey {
+	key := sortKey{id: m.ID.String()}
+	switch field {
+	case "vote_average":
+		key.value = ptr(strconv.FormatFloat(m.VoteAverage, 'g', -1, 64))
+	case "release_date":
+		key.value = optional(m.ReleaseDate)
+	default:
+		key.value = ptr(strconv.FormatFloat(m.Popularity, 'g', -1, 64))
+	}
+	return key
+}
+
+func cursorKey(cursor, field, dir string) (sortKey, error) {
+	c, err := pagination.DecodeFor(cursor, field+"."+dir)
+	if err != nil {
+		return sortKey{}, err
+	}
+	if c.Value != nil && field != "release_date" {
+		if _, err := strconv.ParseFloat(*c.Value, 64); err != nil {
+			return sortKey{}, pagination.ErrInvalidCursor
+		}
+	}
+	return sortKey{value: c.Value, id: c.ID}, nil
+}
+
+// compareKeys reproduces the ORDER BY built by movierepo.DiscoverMovies,
+// "<col> <dir> NULLS LAST, m.id <dir>": negative means a sorts before b.
+func compareKeys(a, b sortKey, dir string) int {
+	if (a.value == nil) != (b.value == nil) {
+		if a.value == nil {
+			return 1 // NULLS LAST
+		}
+		return -1
+	}
+	cmp := 0
+	if a.value != nil {
+		cmp = compareValues(*a.value, *b.value)
+	}
+	if cmp == 0 {
+		cmp = strings.Compare(a.id, b.id)
+	}
+	if dir == "desc" {
+		return -cmp
+	}
+	return cmp
+}
+
+// compareValues compares numerically when both values are numbers; release
+// dates are YYYY-MM-DD, so string order is date order.
+func compareValues(a, b string) int {
+	fa, errA := strconv.ParseFloat(a, 64)
+	fb, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		return compareFloat(fa, fb)
+	}
+	return strings.Compare(a, b)
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
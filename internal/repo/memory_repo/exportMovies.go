@@ -0,0 +1,83 @@
+package memoryrepo
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) ListMovies(ctx context.Context, since *time.Time, after uuid.UUID, limit int) ([]model.MovieResponse, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var res []model.MovieResponse
+	for _, m := range r.movies {
+		if bytes.Compare(m.ID.Bytes(), after.Bytes()) <= 0 || (since != nil && m.UpdatedAt.Before(*since)) {
+			continue
+		}
+		res = append(res, model.MovieResponse{
+			ID:           m.ID,
+			Title:        m.Title,
+			Overview:     ptr(m.Overview),
+			ReleaseDate:  optional(m.ReleaseDate),
+			VoteAverage:  ptr(m.VoteAverage),
+			VoteCount:    ptr(m.VoteCount),
+			PosterPath:   ptr(m.PosterPath),
+			BackdropPath: ptr(m.BackdropPath),
+			Budget:       ptr(m.Budget),
+			Revenue:      ptr(m.Revenue),
+			Homepage:     ptr(m.Homepage),
+			UpdatedAt:    m.UpdatedAt,
+		})
+	}
+	// ORDER BY m.id LIMIT $3
+	sort.Slice(res, func(i, j int) bool { return bytes.Compare(res[i].ID.Bytes(), res[j].ID.Bytes()) < 0 })
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+// FetchAppends has no round trips to save, so it just runs the single-movie
+// fetches for every id.
+func (r *Memory_repo) FetchAppends(ctx context.Context, ids []uuid.UUID) (model.ExportAppends, error) {
+	res := model.ExportAppends{
+		Genres:          make(map[uuid.UUID][]model.Genre),
+		Companies:       make(map[uuid.UUID][]model.ProductionCompany),
+		Credits:         make(map[uuid.UUID][]model.MovieCredit),
+		Images:          make(map[uuid.UUID][]model.Image),
+		Videos:          make(map[uuid.UUID][]model.Video),
+		SpokenLanguages: make(map[uuid.UUID][]model.Language),
+		Translations:    make(map[uuid.UUID][]model.MovieTranslation),
+	}
+	for _, movieID := range ids {
+		id := movieID.String()
+		var err error
+		if res.Genres[movieID], err = r.FetchGenres(ctx, id, "en"); err != nil {
+			return res, err
+		}
+		if res.Companies[movieID], err = r.FetchCompanies(ctx, id); err != nil {
+			return res, err
+		}
+		if res.Credits[movieID], err = r.FetchCredits(ctx, id); err != nil {
+			return res, err
+		}
+		if res.Images[movieID], err = r.FetchImages(ctx, id, model.LanguageFilter{}); err != nil {
+			return res, err
+		}
+		if res.Videos[movieID], err = r.FetchVideos(ctx, id, model.LanguageFilter{}); err != nil {
+			return res, err
+		}
+		if res.SpokenLanguages[movieID], err = r.FetchSpokenLanguages(ctx, id); err != nil {
+			return res, err
+		}
+		if res.Translations[movieID], err = r.FetchTranslations(ctx, id); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,28 @@
+package memoryrepo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) FetchCompanies(ctx context.Context, id string) ([]model.ProductionCompany, error) {
+	movieID, err := parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("Error Query Fetch Companies: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var resp []model.ProductionCompany
+	for _, cid := range r.movies[movieID].CompanyIDs {
+		if c, ok := r.companies[cid]; ok {
+			resp = append(resp, model.ProductionCompany{ID: c.ID, Name: c.Name, OriginCountry: optional(c.OriginCountry)})
+		}
+	}
+	sort.Slice(resp, func(i, j int) bool { return resp[i].Name < resp[j].Name })
+	return resp, nil
+}
@@ -0,0 +1,53 @@
+package memoryrepo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) FetchCompanyMovies(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) ([]model.DiscoverItem, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	companyID, err := parseID(id)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error Querying company movies: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matches []fixtures.Movie
+	for _, movieID := range r.movieOrder {
+		m := r.movies[movieID]
+		if !includeAdult && m.Adult {
+			continue
+		}
+		for _, cid := range m.CompanyIDs {
+			if cid == companyID {
+				matches = append(matches, m)
+				break
+			}
+		}
+	}
+
+	// ORDER BY m.release_date DESC NULLS LAST, m.id DESC
+	sort.SliceStable(matches, func(i, j int) bool {
+		return compareKeys(movieKey(matches[i], "release_date"), movieKey(matches[j], "release_date"), "desc") < 0
+	})
+
+	items := []model.DiscoverItem{}
+	for _, m := range paginate(matches, offset, pageSize) {
+		items = append(items, r.discoverItem(m, lang))
+	}
+	return items, len(matches), nil
+}
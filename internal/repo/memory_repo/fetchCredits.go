@@ -0,0 +1,79 @@
+package memoryrepo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) FetchCredits(ctx context.Context, id string) ([]model.MovieCredit, error) {
+	movieID, err := parseID(id)
+	if err != nil {
+		return []model.MovieCredit{}, fmt.Errorf("Query FetchCredits : %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	resp := []model.MovieCredit{}
+	for _, c := range r.credits[movieID] {
+		p, ok := r.people[c.PersonID]
+		if !ok {
+			continue
+		}
+		resp = append(resp, model.MovieCredit{
+			CreditID:    c.ID,
+			ID:          p.ID,
+			Name:        p.Name,
+			KnownFor:    optional(p.KnownFor),
+			ProfilePath: optional(p.ProfilePath),
+			CreditType:  c.CreditType,
+			Character:   c.CharacterName,
+			CastOrder:   c.CastOrder,
+			Department:  c.Department,
+			Job:         c.Job,
+		})
+	}
+
+	// ORDER BY c.credit_type, c.cast_order ASC NULLS LAST, c.department, c.job, p.name, c.id
+	sort.SliceStable(resp, func(i, j int) bool {
+		a, b := resp[i], resp[j]
+		if a.CreditType != b.CreditType {
+			return a.CreditType < b.CreditType
+		}
+		if (a.CastOrder == nil) != (b.CastOrder == nil) {
+			return a.CastOrder != nil
+		}
+		if a.CastOrder != nil && *a.CastOrder != *b.CastOrder {
+			return *a.CastOrder < *b.CastOrder
+		}
+		if d := compareOptional(a.Department, b.Department); d != 0 {
+			return d < 0
+		}
+		if d := compareOptional(a.Job, b.Job); d != 0 {
+			return d < 0
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.CreditID.String() < b.CreditID.String()
+	})
+	return resp, nil
+}
+
+// compareOptional orders strings the way PostgreSQL does by default: NULLs
+// last.
+func compareOptional(a, b *string) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	return strings.Compare(*a, *b)
+}
@@ -0,0 +1,43 @@
+package memoryrepo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) FetchGenres(ctx context.Context, id, lang string) ([]model.Genre, error) {
+	movieID, err := parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("Error Query FetchGenres: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var res []model.Genre
+	for _, gid := range r.movies[movieID].GenreIDs {
+		if g, ok := r.genres[gid]; ok {
+			res = append(res, model.Genre{ID: g.ID, Name: r.genreName(g, lang)})
+		}
+	}
+	// ORDER BY name, g.id: the localized name, as returned
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Name != res[j].Name {
+			return res[i].Name < res[j].Name
+		}
+		return res[i].ID.String() < res[j].ID.String()
+	})
+	return res, nil
+}
+
+// genreName mirrors COALESCE(gt.name, g.name).
+func (r *Memory_repo) genreName(g fixtures.Genre, lang string) string {
+	if name, ok := r.genreTranslations[g.ID][lang]; ok {
+		return name
+	}
+	return g.Name
+}
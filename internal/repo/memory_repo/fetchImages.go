@@ -0,0 +1,67 @@
+package memoryrepo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) FetchImages(ctx context.Context, id string, filter model.LanguageFilter) ([]model.Image, error) {
+	movieID, err := parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("Error Query FetchImages: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var res []model.Image
+	for _, img := range r.images[movieID] {
+		if filter.Active() && !matchesLanguage(img.Language, filter) {
+			continue
+		}
+		it := model.Image{
+			Type:     img.Type,
+			FilePath: img.FilePath,
+			Width:    img.Width,
+			Height:   img.Height,
+			Language: img.Language,
+		}
+		if it.Height > 0 {
+			it.AspectRatio = float64(it.Width) / float64(it.Height)
+		}
+		res = append(res, it)
+	}
+
+	// ORDER BY i.type, i.width DESC
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Type != res[j].Type {
+			return imageTypeOrder(res[i].Type) < imageTypeOrder(res[j].Type)
+		}
+		return res[i].Width > res[j].Width
+	})
+	return res, nil
+}
+
+// matchesLanguage mirrors language = ANY(languages) OR (include_null AND language IS NULL).
+func matchesLanguage(lang *string, filter model.LanguageFilter) bool {
+	if lang == nil {
+		return filter.IncludeNull
+	}
+	return slices.Contains(filter.Languages, *lang)
+}
+
+// imageTypeOrder is the declaration order of the image_type enum, which is
+// how PostgreSQL sorts it.
+func imageTypeOrder(typ string) int {
+	switch typ {
+	case "poster":
+		return 0
+	case "backdrop":
+		return 1
+	}
+	return 2
+}
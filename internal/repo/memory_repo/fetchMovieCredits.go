@@ -0,0 +1,58 @@
+package memoryrepo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) FetchMovieCredits(ctx context.Context, id, lang string) ([]model.PersonMovieCredit, error) {
+	personID, err := parseID(id)
+	if err != nil {
+		return []model.PersonMovieCredit{}, fmt.Errorf("Query FetchMovieCredits : %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	resp := []model.PersonMovieCredit{}
+	for _, movieID := range r.movieOrder {
+		m := r.movies[movieID]
+		for _, c := range r.credits[movieID] {
+			if c.PersonID != personID {
+				continue
+			}
+			title, _ := r.localized(m, lang)
+			resp = append(resp, model.PersonMovieCredit{
+				CreditID:    c.ID,
+				MovieID:     m.ID,
+				Title:       title,
+				ReleaseDate: optional(m.ReleaseDate),
+				PosterPath:  optional(m.PosterPath),
+				CreditType:  c.CreditType,
+				Character:   c.CharacterName,
+				CastOrder:   c.CastOrder,
+				Department:  c.Department,
+				Job:         c.Job,
+			})
+		}
+	}
+
+	// ORDER BY m.release_date ASC NULLS LAST, title, c.id
+	sort.SliceStable(resp, func(i, j int) bool {
+		a, b := resp[i], resp[j]
+		if (a.ReleaseDate == nil) != (b.ReleaseDate == nil) {
+			return a.ReleaseDate != nil
+		}
+		if a.ReleaseDate != nil && *a.ReleaseDate != *b.ReleaseDate {
+			return *a.ReleaseDate < *b.ReleaseDate
+		}
+		if a.Title != b.Title {
+			return a.Title < b.Title
+		}
+		return a.CreditID.String() < b.CreditID.String()
+	})
+	return resp, nil
+}
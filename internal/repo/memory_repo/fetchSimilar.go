@@ -0,0 +1,113 @@
+package memoryrepo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	movierepo "github.com/h-raju-arch/movie_app_backend/internal/repo/movie_repo"
+)
+
+func (r *Memory_repo) FetchSimilar(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) ([]model.DiscoverItem, int, error) {
+	movieID, err := parseID(id)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error Querying similar movies: %w", err)
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	src := r.movies[movieID]
+	scores := make(map[uuid.UUID]float64)
+	for _, m := range r.movies {
+		if m.ID == movieID {
+			continue
+		}
+		for _, gid := range src.GenreIDs {
+			if slices.Contains(m.GenreIDs, gid) {
+				scores[m.ID] += movierepo.SimilarGenreWeight
+			}
+		}
+		for _, cid := range src.CompanyIDs {
+			if slices.Contains(m.CompanyIDs, cid) {
+				scores[m.ID] += movierepo.SimilarCompanyWeight
+			}
+		}
+	}
+	for _, s := range r.credits[movieID] {
+		for otherID, credits := range r.credits {
+			if otherID == movieID {
+				continue
+			}
+			for _, o := range credits {
+				if weight, ok := sharedCreditWeight(s, o); ok {
+					scores[otherID] += weight
+				}
+			}
+		}
+	}
+
+	type scored struct {
+		m     fixtures.Movie
+		score float64
+	}
+	var matches []scored
+	for otherID, score := range scores {
+		m := r.movies[otherID]
+		if m.Adult && !includeAdult {
+			continue
+		}
+		matches = append(matches, scored{m: m, score: score})
+	}
+
+	// ORDER BY s.score DESC, ms.popularity DESC NULLS LAST, m.id
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		if a.m.Popularity != b.m.Popularity {
+			return a.m.Popularity > b.m.Popularity
+		}
+		return a.m.ID.String() < b.m.ID.String()
+	})
+
+	items := []model.DiscoverItem{}
+	for _, s := range paginate(matches, offset, pageSize) {
+		items = append(items, r.discoverItem(s.m, lang))
+	}
+	return items, len(matches), nil
+}
+
+// sharedCreditWeight mirrors the credits branch of the overlap CTE: the same
+// person top-billed in both casts, or directing or scoring both movies.
+func sharedCreditWeight(s, o fixtures.Credit) (float64, bool) {
+	if o.PersonID != s.PersonID || o.CreditType != s.CreditType {
+		return 0, false
+	}
+	if s.CreditType == "cast" {
+		billed := func(c fixtures.Credit) bool { return c.CastOrder != nil && *c.CastOrder <= movierepo.SimilarCastDepth }
+		return movierepo.SimilarCastWeight, billed(s) && billed(o)
+	}
+	if s.Job == nil || o.Job == nil || *s.Job != *o.Job {
+		return 0, false
+	}
+	switch *s.Job {
+	case "Director":
+		return movierepo.SimilarDirectorWeight, true
+	case "Original Music Composer":
+		return movierepo.SimilarComposerWeight, true
+	}
+	return 0, false
+}
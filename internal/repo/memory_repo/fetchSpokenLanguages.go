@@ -0,0 +1,29 @@
+package memoryrepo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) FetchSpokenLanguages(ctx context.Context, id string) ([]model.Language, error) {
+	movieID, err := parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("Error Query FetchSpokenLanguages: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var res []model.Language
+	for _, iso := range r.movies[movieID].SpokenLanguages {
+		if l, ok := r.language(iso); ok {
+			res = append(res, l)
+		}
+	}
+	// ORDER BY l.name
+	sort.SliceStable(res, func(i, j int) bool { return res[i].EnglishName < res[j].EnglishName })
+	return res, nil
+}
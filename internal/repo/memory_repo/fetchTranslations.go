@@ -0,0 +1,35 @@
+package memoryrepo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) FetchTranslations(ctx context.Context, id string) ([]model.MovieTranslation, error) {
+	movieID, err := parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("Error Query FetchTranslations: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := []model.MovieTranslation{}
+	for lang, t := range r.translations[movieID] {
+		mt := model.MovieTranslation{
+			ISO6391: lang,
+			Data:    model.TranslationData{Title: optional(t.Title), Overview: optional(t.Overview)},
+		}
+		// LEFT JOIN languages
+		if l, ok := r.language(lang); ok {
+			mt.EnglishName, mt.Name = &l.EnglishName, &l.Name
+		}
+		res = append(res, mt)
+	}
+	// ORDER BY mt.language
+	sort.Slice(res, func(i, j int) bool { return res[i].ISO6391 < res[j].ISO6391 })
+	return res, nil
+}
@@ -0,0 +1,62 @@
+package memoryrepo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) FetchVideos(ctx context.Context, id string, filter model.LanguageFilter) ([]model.Video, error) {
+	movieID, err := parseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("Error Query FetchVideos: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var videos []fixtures.Video
+	for _, v := range r.videos[movieID] {
+		if filter.Active() && !matchesLanguage(v.Language, filter) {
+			continue
+		}
+		videos = append(videos, v)
+	}
+
+	// ORDER BY v.official DESC, v.published_at DESC NULLS LAST, v.id
+	sort.SliceStable(videos, func(i, j int) bool {
+		a, b := videos[i], videos[j]
+		if a.Official != b.Official {
+			return a.Official
+		}
+		if (a.PublishedAt == nil) != (b.PublishedAt == nil) {
+			return a.PublishedAt != nil
+		}
+		if a.PublishedAt != nil && !a.PublishedAt.Equal(*b.PublishedAt) {
+			return a.PublishedAt.After(*b.PublishedAt)
+		}
+		return a.ID.String() < b.ID.String()
+	})
+
+	var res []model.Video
+	for _, v := range videos {
+		it := model.Video{
+			ID:       v.ID,
+			Site:     v.Site,
+			Key:      v.Key,
+			Name:     v.Name,
+			Type:     v.Type,
+			Official: v.Official,
+			Language: v.Language,
+		}
+		if v.PublishedAt != nil {
+			it.PublishedAt = ptr(v.PublishedAt.UTC().Format(time.RFC3339))
+		}
+		res = append(res, it)
+	}
+	return res, nil
+}
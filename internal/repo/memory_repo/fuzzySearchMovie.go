@@ -0,0 +1,63 @@
+package memoryrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/h-raju-arch/movie_app_backend/internal/pagination"
+	movierepo "github.com/h-raju-arch/movie_app_backend/internal/repo/movie_repo"
+)
+
+func (r *Memory_repo) FuzzySearchMovie(ctx context.Context, queryStr string, adult bool, lang string, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	offset := (page - 1) * pageSize
+
+	after, err := searchAfter(cursor, pagination.SortFuzzySearch)
+	if err != nil {
+		return 0, []model.MovieSearchItem{}, fmt.Errorf("Fuzzy search movie cursor: %w", err)
+	}
+	if after != nil {
+		offset = 0
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matches []model.MovieSearchItem
+	for _, id := range r.movieOrder {
+		m := r.movies[id]
+		title, overview := r.localized(m, lang)
+
+		sim := max(similarity(title, queryStr), similarity(m.OriginalTitle, queryStr))
+		if sim < movierepo.FuzzySimilarityThreshold {
+			continue
+		}
+		if !r.searchFilter(m, adult, primaryYear, region) {
+			continue
+		}
+
+		matches = append(matches, model.MovieSearchItem{
+			ID:          m.ID,
+			Title:       title,
+			Overview:    ptr(overview),
+			ReleaseDate: optional(m.ReleaseDate),
+			VoteAverage: ptr(m.VoteAverage),
+			Popularity:  ptr(m.Popularity),
+			Score:       0.8*sim + 0.2*m.Popularity/(m.Popularity+100),
+			Similarity:  ptr(sim),
+		})
+	}
+
+	matches = rankSearch(matches, after)
+	result := append([]model.MovieSearchItem{}, paginate(matches, offset, pageSize+1)...)
+	return len(matches), result, nil
+}
@@ -0,0 +1,30 @@
+package memoryrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) GetCompanyById(ctx context.Context, id string) (model.CompanyResponse, error) {
+	companyID, err := parseID(id)
+	if err != nil {
+		return model.CompanyResponse{}, fmt.Errorf("Query company: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.companies[companyID]
+	if !ok {
+		return model.CompanyResponse{}, fmt.Errorf("Query company: %w", sql.ErrNoRows)
+	}
+	return model.CompanyResponse{
+		ID:            c.ID,
+		Name:          c.Name,
+		OriginCountry: optional(c.OriginCountry),
+		Homepage:      optional(c.Homepage),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package memoryrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) GetMovieBasebyId(ctx context.Context, id, lang string) (model.MovieResponse, error) {
+	movieID, err := parseID(id)
+	if err != nil {
+		return model.MovieResponse{}, fmt.Errorf("Query movie base: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	m, ok := r.movies[movieID]
+	if !ok {
+		return model.MovieResponse{}, fmt.Errorf("Query movie base: %w", sql.ErrNoRows)
+	}
+
+	title, overview := r.localized(m, lang)
+	return model.MovieResponse{
+		ID:           m.ID,
+		Title:        title,
+		Overview:     ptr(overview),
+		ReleaseDate:  optional(m.ReleaseDate),
+		VoteAverage:  ptr(m.VoteAverage),
+		VoteCount:    ptr(m.VoteCount),
+		PosterPath:   ptr(m.PosterPath),
+		BackdropPath: ptr(m.BackdropPath),
+		Budget:       ptr(m.Budget),
+		Revenue:      ptr(m.Revenue),
+		Homepage:     ptr(m.Homepage),
+		UpdatedAt:    m.UpdatedAt,
+	}, nil
+}
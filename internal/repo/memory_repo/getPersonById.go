@@ -0,0 +1,30 @@
+package memoryrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) GetPersonById(ctx context.Context, id string) (model.PersonResponse, error) {
+	personID, err := parseID(id)
+	if err != nil {
+		return model.PersonResponse{}, fmt.Errorf("Query person: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p, ok := r.people[personID]
+	if !ok {
+		return model.PersonResponse{}, fmt.Errorf("Query person: %w", sql.ErrNoRows)
+	}
+	return model.PersonResponse{
+		ID:          p.ID,
+		Name:        p.Name,
+		KnownFor:    optional(p.KnownFor),
+		ProfilePath: optional(p.ProfilePath),
+	}, nil
+}
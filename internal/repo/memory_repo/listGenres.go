@@ -0,0 +1,27 @@
+package memoryrepo
+
+import (
+	"context"
+	"sort"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) ListGenres(ctx context.Context, lang string) ([]model.Genre, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := []model.Genre{}
+	for _, g := range r.genres {
+		res = append(res, model.Genre{ID: g.ID, Name: r.genreName(g, lang)})
+	}
+
+	// ORDER BY name, g.id
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Name != res[j].Name {
+			return res[i].Name < res[j].Name
+		}
+		return res[i].ID.String() < res[j].ID.String()
+	})
+	return res, nil
+}
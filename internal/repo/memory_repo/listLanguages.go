@@ -0,0 +1,59 @@
+package memoryrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) ListLanguages(ctx context.Context) ([]model.Language, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := []model.Language{}
+	for _, l := range r.languages {
+		res = append(res, languageModel(l))
+	}
+	// ORDER BY l.name, l.iso_639_1
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].EnglishName != res[j].EnglishName {
+			return res[i].EnglishName < res[j].EnglishName
+		}
+		return res[i].ISO6391 < res[j].ISO6391
+	})
+	return res, nil
+}
+
+func (r *Memory_repo) GetLanguage(ctx context.Context, iso string) (model.Language, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	l, ok := r.language(iso)
+	if !ok {
+		return model.Language{}, fmt.Errorf("Error Query GetLanguage: %w", sql.ErrNoRows)
+	}
+	return l, nil
+}
+
+// language looks a language up by its iso_639_1 code.
+func (r *Memory_repo) language(iso string) (model.Language, bool) {
+	for _, l := range r.languages {
+		if l.ISO == iso {
+			return languageModel(l), true
+		}
+	}
+	return model.Language{}, false
+}
+
+// languageModel mirrors COALESCE(l.native_name, l.name).
+func languageModel(l fixtures.Language) model.Language {
+	native := l.NativeName
+	if native == "" {
+		native = l.Name
+	}
+	return model.Language{ISO6391: l.ISO, EnglishName: l.Name, Name: native}
+}
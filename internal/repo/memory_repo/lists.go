@@ -0,0 +1,97 @@
+package memoryrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	accountrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/account_repo"
+)
+
+func (r *Memory_repo) SetListed(ctx context.Context, list accountrepo.List, userID uuid.UUID, movieID string, on bool) error {
+	id, err := parseID(movieID)
+	if err != nil {
+		return fmt.Errorf("Error Query SetListed %s: %w", list, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.movies[id]; !ok {
+		return fmt.Errorf("Error Query SetListed %s: %w", list, sql.ErrNoRows)
+	}
+	if r.lists[list] == nil {
+		r.lists[list] = make(map[uuid.UUID]map[uuid.UUID]time.Time)
+	}
+	listed := r.lists[list][userID]
+	if listed == nil {
+		listed = make(map[uuid.UUID]time.Time)
+		r.lists[list][userID] = listed
+	}
+
+	if !on {
+		delete(listed, id)
+	} else if _, ok := listed[id]; !ok {
+		// ON CONFLICT DO NOTHING keeps the original created_at
+		listed[id] = time.Now()
+	}
+	return nil
+}
+
+func (r *Memory_repo) FetchListedMovies(ctx context.Context, list accountrepo.List, userID uuid.UUID, lang string, page, pageSize int) ([]model.DiscoverItem, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	type listed struct {
+		item      model.DiscoverItem
+		createdAt time.Time
+	}
+	var matches []listed
+	for movieID, createdAt := range r.lists[list][userID] {
+		matches = append(matches, listed{item: r.discoverItem(r.movies[movieID], lang), createdAt: createdAt})
+	}
+
+	// ORDER BY l.created_at DESC, m.id
+	sort.Slice(matches, func(i, j int) bool {
+		if !matches[i].createdAt.Equal(matches[j].createdAt) {
+			return matches[i].createdAt.After(matches[j].createdAt)
+		}
+		return matches[i].item.ID.String() < matches[j].item.ID.String()
+	})
+
+	items := []model.DiscoverItem{}
+	for _, m := range paginate(matches, offset, pageSize) {
+		items = append(items, m.item)
+	}
+	return items, len(matches), nil
+}
+
+func (r *Memory_repo) FetchAccountStates(ctx context.Context, id string, userID uuid.UUID) (model.AccountStates, error) {
+	movieID, err := parseID(id)
+	if err != nil {
+		return model.AccountStates{}, fmt.Errorf("Error Query FetchAccountStates: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := model.AccountStates{ID: movieID}
+	_, res.Watchlist = r.lists[accountrepo.Watchlist][userID][movieID]
+	_, res.Favorite = r.lists[accountrepo.Favorites][userID][movieID]
+	if rt, ok := r.ratings[userID][movieID]; ok {
+		res.Rated = &rt.value
+	}
+	return res, nil
+}
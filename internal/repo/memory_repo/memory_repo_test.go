@@ -0,0 +1,373 @@
+package memoryrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	viewrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/view_repo"
+)
+
+func newTestRepo(t *testing.T) (*Memory_repo, *fixtures.Dataset) {
+	t.Helper()
+	ds := fixtures.Build(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	return New_Memory_Repo(ds), ds
+}
+
+func movieByTitle(t *testing.T, ds *fixtures.Dataset, title string) fixtures.Movie {
+	t.Helper()
+	for _, m := range ds.Movies {
+		if m.Title == title {
+			return m
+		}
+	}
+	t.Fatalf("fixture movie %q not found", title)
+	return fixtures.Movie{}
+}
+
+func genreID(t *testing.T, ds *fixtures.Dataset, name string) string {
+	t.Helper()
+	for _, g := range ds.Genres {
+		if g.Name == name {
+			return g.ID.String()
+		}
+	}
+	t.Fatalf("fixture genre %q not found", name)
+	return ""
+}
+
+func TestGetMovieBasebyId_LanguageFallback(t *testing.T) {
+	repo, ds := newTestRepo(t)
+	inception := movieByTitle(t, ds, "Inception")
+	matrix := movieByTitle(t, ds, "The Matrix")
+
+	res, err := repo.GetMovieBasebyId(context.Background(), inception.ID.String(), "es")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if res.Title != "Inception (Español)" {
+		t.Errorf("expected translated title, got %s", res.Title)
+	}
+
+	// The Matrix has no translations, so the base title is used
+	res, err = repo.GetMovieBasebyId(context.Background(), matrix.ID.String(), "es")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if res.Title != "The Matrix" {
+		t.Errorf("expected base title, got %s", res.Title)
+	}
+}
+
+func TestGetMovieBasebyId_NotFound(t *testing.T) {
+	repo, _ := newTestRepo(t)
+
+	_, err := repo.GetMovieBasebyId(context.Background(), "0195f6f0-0000-7000-8000-000000000000", "en")
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+}
+
+func TestSearchMovie_RanksByRelevance(t *testing.T) {
+	repo, _ := newTestRepo(t)
+
+	// La La Land has "dream" in its tag line, the more popular Inception
+	// only in its overview
+	total, items, err := repo.SearchMovie(context.Background(), "DREAM", false, "en", sql.NullInt64{}, sql.NullString{}, 1, 20, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if total != 2 || len(items) != 2 {
+		t.Fatalf("expected 2 matches, got total %d items %d", total, len(items))
+	}
+	if items[0].Title != "La La Land" || items[1].Title != "Inception" {
+		t.Errorf("expected La La Land before Inception, got %s, %s", items[0].Title, items[1].Title)
+	}
+	if items[0].Score <= items[1].Score {
+		t.Errorf("expected descending scores, got %f, %f", items[0].Score, items[1].Score)
+	}
+}
+
+func TestSearchMovie_TranslatedText(t *testing.T) {
+	repo, _ := newTestRepo(t)
+
+	total, items, _ := repo.SearchMovie(context.Background(), "español", false, "es", sql.NullInt64{}, sql.NullString{}, 1, 100, "")
+	if total == 0 {
+		t.Fatal("expected matches in translated text")
+	}
+	for _, it := range items {
+		if !strings.HasSuffix(it.Title, "(Español)") {
+			t.Errorf("unexpected match %s", it.Title)
+		}
+	}
+
+	total, _, _ = repo.SearchMovie(context.Background(), "español", false, "en", sql.NullInt64{}, sql.NullString{}, 1, 100, "")
+	if total != 0 {
+		t.Errorf("expected other languages' translations to be ignored, got %d", total)
+	}
+}
+
+func TestSearchMovie_AdultYearRegion(t *testing.T) {
+	repo, _ := newTestRepo(t)
+	ctx := context.Background()
+
+	total, _, _ := repo.SearchMovie(ctx, "Oldboy", false, "en", sql.NullInt64{}, sql.NullString{}, 1, 20, "")
+	if total != 0 {
+		t.Errorf("expected adult movie to be hidden, got %d", total)
+	}
+	total, _, _ = repo.SearchMovie(ctx, "Oldboy", true, "en", sql.NullInt64{}, sql.NullString{}, 1, 20, "")
+	if total != 1 {
+		t.Errorf("expected adult movie with include_adult, got %d", total)
+	}
+
+	total, _, _ = repo.SearchMovie(ctx, "interstellar", false, "en", sql.NullInt64{Int64: 2014, Valid: true}, sql.NullString{}, 1, 20, "")
+	if total != 1 {
+		t.Errorf("expected 2014 match, got %d", total)
+	}
+	total, _, _ = repo.SearchMovie(ctx, "interstellar", false, "en", sql.NullInt64{Int64: 2015, Valid: true}, sql.NullString{}, 1, 20, "")
+	if total != 0 {
+		t.Errorf("expected no 2015 match, got %d", total)
+	}
+
+	total, _, _ = repo.SearchMovie(ctx, "Parasite", false, "en", sql.NullInt64{}, sql.NullString{String: "US", Valid: true}, 1, 20, "")
+	if total != 0 {
+		t.Errorf("expected no US company match for Parasite, got %d", total)
+	}
+}
+
+func TestSearchMovie_Pagination(t *testing.T) {
+	repo, _ := newTestRepo(t)
+
+	total, page2, err := repo.SearchMovie(context.Background(), "world", true, "en", sql.NullInt64{}, sql.NullString{}, 2, 2, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if total <= 4 {
+		t.Fatalf("expected more than two pages, got total %d", total)
+	}
+	// one extra row tells the service there is a next page
+	if len(page2) != 3 {
+		t.Errorf("expected 2 results on page 2 plus one, got %d", len(page2))
+	}
+}
+
+func TestFuzzySearchMovie_Typos(t *testing.T) {
+	repo, _ := newTestRepo(t)
+
+	for query, want := range map[string]string{"interstelar": "Interstellar", "godfater": "The Godfather"} {
+		total, items, err := repo.FuzzySearchMovie(context.Background(), query, false, "en", sql.NullInt64{}, sql.NullString{}, 1, 20, "")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if total == 0 || items[0].Title != want {
+			t.Errorf("%s: expected %s first, got %v", query, want, items)
+			continue
+		}
+		if items[0].Similarity == nil || *items[0].Similarity < 0.3 {
+			t.Errorf("%s: expected similarity above threshold, got %v", query, items[0].Similarity)
+		}
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	if got := similarity("word", "word"); got != 1 {
+		t.Errorf("expected identical strings to score 1, got %f", got)
+	}
+	if got := similarity("abc", "xyz"); got != 0 {
+		t.Errorf("expected disjoint strings to score 0, got %f", got)
+	}
+	// pg_trgm: SELECT similarity('word', 'two words') = 0.363636
+	if got := similarity("word", "two words"); got < 0.36 || got > 0.37 {
+		t.Errorf("expected ~0.3636, got %f", got)
+	}
+}
+
+func TestDiscoverMovies_Genres(t *testing.T) {
+	repo, ds := newTestRepo(t)
+	ctx := context.Background()
+	action := genreID(t, ds, "Action")
+	scifi := genreID(t, ds, "Science Fiction")
+
+	_, andTotal, err := repo.DiscoverMovies(ctx, model.DiscoverMoviesParams{WithGenres: []string{action, scifi}, WithGenresAND: true})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, orTotal, _ := repo.DiscoverMovies(ctx, model.DiscoverMoviesParams{WithGenres: []string{action, scifi}})
+	if andTotal == 0 || andTotal >= orTotal {
+		t.Errorf("expected AND (%d) to be a strict subset of OR (%d)", andTotal, orTotal)
+	}
+
+	_, _, err = repo.DiscoverMovies(ctx, model.DiscoverMoviesParams{WithGenres: []string{"action"}})
+	if err == nil {
+		t.Error("expected error for non-uuid genre")
+	}
+}
+
+func TestDiscoverMovies_SortAndFilters(t *testing.T) {
+	repo, ds := newTestRepo(t)
+	gte := 8.5
+	from := "2000-01-01"
+
+	items, total, err := repo.DiscoverMovies(context.Background(), model.DiscoverMoviesParams{
+		SortBy:         "release_date.asc",
+		VoteAvgGTE:     &gte,
+		ReleaseDateGTE: &from,
+		IncludeAdult:   true,
+		PageSize:       100,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if total != len(items) || total == 0 {
+		t.Fatalf("expected matches, got total %d items %d", total, len(items))
+	}
+	for i, it := range items {
+		if *it.VoteAverage < gte || *it.ReleaseDate < from {
+			t.Errorf("filter not applied to %s", it.Title)
+		}
+		if i > 0 && *items[i-1].ReleaseDate > *it.ReleaseDate {
+			t.Errorf("expected ascending release dates")
+		}
+	}
+
+	_, all, _ := repo.DiscoverMovies(context.Background(), model.DiscoverMoviesParams{})
+	if all != len(ds.Movies)-1 {
+		t.Errorf("expected every non-adult movie, got %d of %d", all, len(ds.Movies))
+	}
+}
+
+func TestFetchGenres_LocalizedOrder(t *testing.T) {
+	repo, ds := newTestRepo(t)
+
+	for _, lang := range []string{"en", "es", "ja"} {
+		for _, m := range ds.Movies {
+			genres, err := repo.FetchGenres(context.Background(), m.ID.String(), lang)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			for i := 1; i < len(genres); i++ {
+				if genres[i-1].Name > genres[i].Name {
+					t.Errorf("%s in %s: expected genres sorted by the returned name, got %s before %s", m.Title, lang, genres[i-1].Name, genres[i].Name)
+				}
+			}
+		}
+	}
+}
+
+func TestFetchCredits_Ordering(t *testing.T) {
+	repo, ds := newTestRepo(t)
+	inception := movieByTitle(t, ds, "Inception")
+
+	credits, err := repo.FetchCredits(context.Background(), inception.ID.String())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var cast, crew []model.MovieCredit
+	for _, c := range credits {
+		if c.ID.IsNil() || c.ProfilePath == nil {
+			t.Errorf("expected person id and profile path on %s", c.Name)
+		}
+		if c.CreditType == "cast" {
+			if len(crew) > 0 {
+				t.Fatal("expected cast before crew")
+			}
+			cast = append(cast, c)
+		} else {
+			crew = append(crew, c)
+		}
+	}
+	if len(cast) == 0 || len(crew) < 3 {
+		t.Fatalf("expected cast and crew, got %d and %d", len(cast), len(crew))
+	}
+	for i, c := range cast {
+		if c.CastOrder == nil || *c.CastOrder != i+1 || c.Character == nil {
+			t.Errorf("expected cast_order %d with a character, got %+v", i+1, c)
+		}
+	}
+	for i := 1; i < len(crew); i++ {
+		if *crew[i-1].Department > *crew[i].Department {
+			t.Errorf("expected crew grouped by department, got %s before %s", *crew[i-1].Department, *crew[i].Department)
+		}
+	}
+}
+
+func TestFetchSimilar_Scoring(t *testing.T) {
+	repo, ds := newTestRepo(t)
+	inception := movieByTitle(t, ds, "Inception")
+
+	items, total, err := repo.FetchSimilar(context.Background(), inception.ID.String(), "es", false, 1, 100)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if total == 0 || total != len(items) {
+		t.Fatalf("expected similar movies, got total %d items %d", total, len(items))
+	}
+	// same director and composer beat everything else
+	for _, it := range items[:2] {
+		if it.Title != "The Dark Knight (Español)" && it.Title != "Interstellar (Español)" {
+			t.Errorf("expected Nolan/Zimmer movies first, got %s", it.Title)
+		}
+	}
+	for _, it := range items {
+		if it.ID == inception.ID {
+			t.Error("expected the movie itself to be left out")
+		}
+	}
+
+	page2, _, _ := repo.FetchSimilar(context.Background(), inception.ID.String(), "es", false, 2, 2)
+	if len(page2) != 2 || page2[0].ID != items[2].ID {
+		t.Errorf("expected page 2 to continue the ranking")
+	}
+}
+
+func TestFetchSimilar_Adult(t *testing.T) {
+	repo, ds := newTestRepo(t)
+	parasite := movieByTitle(t, ds, "Parasite")
+	oldboy := movieByTitle(t, ds, "Oldboy")
+
+	items, _, _ := repo.FetchSimilar(context.Background(), parasite.ID.String(), "en", true, 1, 1)
+	if len(items) != 1 || items[0].ID != oldboy.ID {
+		t.Errorf("expected Oldboy (same director and composer) first with include_adult, got %v", items)
+	}
+
+	items, _, _ = repo.FetchSimilar(context.Background(), parasite.ID.String(), "en", false, 1, 100)
+	for _, it := range items {
+		if it.ID == oldboy.ID {
+			t.Error("expected the adult movie to be hidden")
+		}
+	}
+}
+
+func TestRecordViews_Trending(t *testing.T) {
+	repo, ds := newTestRepo(t)
+	oldboy := movieByTitle(t, ds, "Oldboy")
+	parasite := movieByTitle(t, ds, "Parasite")
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	w := viewrepo.Window{Since: now.Add(-24 * time.Hour), Now: now, HalfLife: 6 * time.Hour}
+
+	err := repo.RecordViews(context.Background(), []model.MovieViews{
+		{MovieID: oldboy.ID, Hour: now, Views: 5},
+		{MovieID: parasite.ID, Hour: now, Views: 2},
+		{MovieID: parasite.ID, Hour: now, Views: 2},
+		{MovieID: uuid.Must(uuid.NewV7()), Hour: now, Views: 9}, // unknown movies are dropped
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	items, total, _ := repo.FetchTrending(context.Background(), w, "en", false, 1, 20)
+	if total != 1 || items[0].ID != parasite.ID {
+		t.Errorf("expected only Parasite without include_adult, got %v", items)
+	}
+	items, total, _ = repo.FetchTrending(context.Background(), w, "en", true, 1, 20)
+	if total != 2 || items[0].ID != oldboy.ID {
+		t.Errorf("expected Oldboy (5 views) before Parasite (4), got %v", items)
+	}
+}
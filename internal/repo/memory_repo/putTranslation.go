@@ -0,0 +1,59 @@
+package memoryrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) PutTranslation(ctx context.Context, id, lang string, t model.TranslationWrite) (bool, error) {
+	movieID, err := parseID(id)
+	if err != nil {
+		return false, fmt.Errorf("Error Query PutTranslation: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m, ok := r.movies[movieID]
+	if !ok {
+		return false, fmt.Errorf("Error Query PutTranslation: %w", sql.ErrNoRows)
+	}
+
+	if r.translations[movieID] == nil {
+		r.translations[movieID] = make(map[string]fixtures.Translation)
+	}
+	_, existed := r.translations[movieID][lang]
+	tr := fixtures.Translation{MovieID: movieID, Language: lang}
+	setString(&tr.Title, t.Title, true)
+	setString(&tr.Overview, t.Overview, true)
+	r.translations[movieID][lang] = tr
+
+	m.UpdatedAt = time.Now()
+	r.movies[movieID] = m
+	return !existed, nil
+}
+
+func (r *Memory_repo) DeleteTranslation(ctx context.Context, id, lang string) error {
+	movieID, err := parseID(id)
+	if err != nil {
+		return fmt.Errorf("Error Query DeleteTranslation: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.translations[movieID][lang]; !ok {
+		return fmt.Errorf("Error Query DeleteTranslation: %w", sql.ErrNoRows)
+	}
+	delete(r.translations[movieID], lang)
+
+	m := r.movies[movieID]
+	m.UpdatedAt = time.Now()
+	r.movies[movieID] = m
+	return nil
+}
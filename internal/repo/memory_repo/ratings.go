@@ -0,0 +1,119 @@
+package memoryrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// rating is a row of ratings.
+type rating struct {
+	value     float64
+	updatedAt time.Time
+}
+
+func (r *Memory_repo) RateMovie(ctx context.Context, userID uuid.UUID, movieID string, value float64) (model.MovieRating, bool, error) {
+	id, err := parseID(movieID)
+	if err != nil {
+		return model.MovieRating{}, false, fmt.Errorf("Error Query RateMovie: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m, ok := r.movies[id]
+	if !ok {
+		return model.MovieRating{}, false, fmt.Errorf("Error Query lock movie_stats: %w", sql.ErrNoRows)
+	}
+	if r.ratings[userID] == nil {
+		r.ratings[userID] = make(map[uuid.UUID]rating)
+	}
+	old, rated := r.ratings[userID][id]
+	r.ratings[userID][id] = rating{value: value, updatedAt: time.Now()}
+
+	countDelta := 1
+	if rated {
+		countDelta = 0
+	}
+	res := r.applyVote(m, value-old.value, countDelta)
+	res.Rating = &value
+	return res, !rated, nil
+}
+
+func (r *Memory_repo) DeleteRating(ctx context.Context, userID uuid.UUID, movieID string) (model.MovieRating, error) {
+	id, err := parseID(movieID)
+	if err != nil {
+		return model.MovieRating{}, fmt.Errorf("Error Query DeleteRating: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m, ok := r.movies[id]
+	if !ok {
+		return model.MovieRating{}, fmt.Errorf("Error Query lock movie_stats: %w", sql.ErrNoRows)
+	}
+	old, rated := r.ratings[userID][id]
+	if !rated {
+		return model.MovieRating{}, fmt.Errorf("Error Query DeleteRating: %w", sql.ErrNoRows)
+	}
+	delete(r.ratings[userID], id)
+
+	return r.applyVote(m, -old.value, -1), nil
+}
+
+// applyVote mirrors the movie_stats UPDATE: sumDelta is added to
+// vote_average * vote_count and countDelta to vote_count. It bumps the
+// movie's updated_at like the movies UPDATE.
+func (r *Memory_repo) applyVote(m fixtures.Movie, sumDelta float64, countDelta int) model.MovieRating {
+	count := m.VoteCount + countDelta
+	if count > 0 {
+		m.VoteAverage = (m.VoteAverage*float64(m.VoteCount) + sumDelta) / float64(count)
+	} else {
+		m.VoteAverage, count = 0, 0
+	}
+	m.VoteCount = count
+	m.UpdatedAt = time.Now()
+	r.movies[m.ID] = m
+	return model.MovieRating{MovieID: m.ID, VoteAverage: m.VoteAverage, VoteCount: m.VoteCount}
+}
+
+func (r *Memory_repo) FetchRatedMovies(ctx context.Context, userID uuid.UUID, lang string, page, pageSize int) ([]model.RatedMovie, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matches []model.RatedMovie
+	for movieID, rt := range r.ratings[userID] {
+		matches = append(matches, model.RatedMovie{
+			DiscoverItem: r.discoverItem(r.movies[movieID], lang),
+			Rating:       rt.value,
+			RatedAt:      rt.updatedAt,
+		})
+	}
+
+	// ORDER BY r.updated_at DESC, m.id
+	sort.Slice(matches, func(i, j int) bool {
+		if !matches[i].RatedAt.Equal(matches[j].RatedAt) {
+			return matches[i].RatedAt.After(matches[j].RatedAt)
+		}
+		return matches[i].ID.String() < matches[j].ID.String()
+	})
+
+	items := []model.RatedMovie{}
+	items = append(items, paginate(matches, offset, pageSize)...)
+	return items, len(matches), nil
+}
@@ -0,0 +1,39 @@
+package memoryrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) ReplaceMovie(ctx context.Context, id string, w model.MovieWrite) error {
+	return r.updateMovie(id, w, true)
+}
+
+func (r *Memory_repo) PatchMovie(ctx context.Context, id string, w model.MovieWrite) error {
+	return r.updateMovie(id, w, false)
+}
+
+func (r *Memory_repo) updateMovie(id string, w model.MovieWrite, replace bool) error {
+	movieID, err := parseID(id)
+	if err != nil {
+		return fmt.Errorf("Error Query UpdateMovie: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m, ok := r.movies[movieID]
+	if !ok {
+		return fmt.Errorf("Error Query UpdateMovie: %w", sql.ErrNoRows)
+	}
+	if err := r.checkMovieLinks(w); err != nil {
+		return err
+	}
+
+	applyMovieWrite(&m, w, replace)
+	r.movies[movieID] = m
+	return nil
+}
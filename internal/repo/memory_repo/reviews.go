@@ -0,0 +1,138 @@
+package memoryrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) SaveReview(ctx context.Context, id uuid.UUID, movieID string, authorID uuid.UUID, w model.ReviewWrite) (model.Review, bool, error) {
+	mID, err := parseID(movieID)
+	if err != nil {
+		return model.Review{}, false, fmt.Errorf("Error Query SaveReview: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.movies[mID]; !ok {
+		return model.Review{}, false, fmt.Errorf("Error Query SaveReview: %w", sql.ErrNoRows)
+	}
+
+	now := time.Now()
+	rv := model.Review{ID: id, MovieID: mID, AuthorID: authorID, CreatedAt: now}
+	// ON CONFLICT (movie_id, author_id) keeps the id and created_at
+	inserted := true
+	for _, have := range r.reviews {
+		if have.MovieID == mID && have.AuthorID == authorID {
+			rv.ID, rv.CreatedAt = have.ID, have.CreatedAt
+			inserted = false
+			if have.Status == model.ReviewApproved {
+				r.touchMovie(mID, now)
+			}
+			break
+		}
+	}
+	if w.Rating != nil {
+		rv.Rating = ptr(*w.Rating)
+	}
+	rv.Content = w.Content
+	rv.Status = model.ReviewPending
+	rv.UpdatedAt = now
+	r.reviews[rv.ID] = rv
+	return rv, inserted, nil
+}
+
+func (r *Memory_repo) FetchReviews(ctx context.Context, id string, viewer uuid.UUID, page, pageSize int) ([]model.Review, int, error) {
+	movieID, err := parseID(id)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error Query FetchReviews: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// WHERE movie_id = $1 AND (status = 'approved' OR author_id = $2)
+	// ORDER BY created_at DESC, id
+	return r.pageReviews(func(rv model.Review) bool {
+		return rv.MovieID == movieID && (rv.Status == model.ReviewApproved || rv.AuthorID == viewer)
+	}, func(a, b model.Review) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	}, page, pageSize)
+}
+
+func (r *Memory_repo) FetchReviewsByStatus(ctx context.Context, status string, page, pageSize int) ([]model.Review, int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// WHERE status = $1 ORDER BY updated_at, id
+	return r.pageReviews(func(rv model.Review) bool {
+		return rv.Status == status
+	}, func(a, b model.Review) int {
+		return a.UpdatedAt.Compare(b.UpdatedAt)
+	}, page, pageSize)
+}
+
+// pageReviews returns one page of the reviews matching keep, sorted by cmp
+// and then by id.
+func (r *Memory_repo) pageReviews(keep func(model.Review) bool, cmp func(a, b model.Review) int, page, pageSize int) ([]model.Review, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	var matches []model.Review
+	for _, rv := range r.reviews {
+		if keep(rv) {
+			matches = append(matches, rv)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if c := cmp(matches[i], matches[j]); c != 0 {
+			return c < 0
+		}
+		return matches[i].ID.String() < matches[j].ID.String()
+	})
+
+	res := []model.Review{}
+	res = append(res, paginate(matches, offset, pageSize)...)
+	return res, len(matches), nil
+}
+
+func (r *Memory_repo) SetReviewStatus(ctx context.Context, id, status string) (model.Review, error) {
+	reviewID, err := parseID(id)
+	if err != nil {
+		return model.Review{}, fmt.Errorf("Error Query SetReviewStatus: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rv, ok := r.reviews[reviewID]
+	if !ok {
+		return model.Review{}, fmt.Errorf("Error Query SetReviewStatus: %w", sql.ErrNoRows)
+	}
+	old := rv.Status
+	rv.Status = status
+	r.reviews[reviewID] = rv
+	if old == model.ReviewApproved || status == model.ReviewApproved {
+		r.touchMovie(rv.MovieID, time.Now())
+	}
+	return rv, nil
+}
+
+// touchMovie mirrors the movies UPDATE bumping updated_at when the reviews
+// a movie shows change.
+func (r *Memory_repo) touchMovie(id uuid.UUID, now time.Time) {
+	m := r.movies[id]
+	m.UpdatedAt = now
+	r.movies[id] = m
+}
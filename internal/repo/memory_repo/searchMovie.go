@@ -0,0 +1,146 @@
+package memoryrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/h-raju-arch/movie_app_backend/internal/pagination"
+)
+
+func (r *Memory_repo) SearchMovie(ctx context.Context, queryStr string, adult bool, lang string, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	offset := (page - 1) * pageSize
+	terms := tokenize(queryStr)
+
+	after, err := searchAfter(cursor, pagination.SortSearch)
+	if err != nil {
+		return 0, []model.MovieSearchItem{}, fmt.Errorf("Search movie cursor: %w", err)
+	}
+	if after != nil {
+		offset = 0
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matches []model.MovieSearchItem
+	for _, id := range r.movieOrder {
+		m := r.movies[id]
+
+		score, ok := r.searchScore(m, terms, lang)
+		if !ok {
+			continue
+		}
+		if !r.searchFilter(m, adult, primaryYear, region) {
+			continue
+		}
+		title, overview := r.localized(m, lang)
+		matches = append(matches, model.MovieSearchItem{
+			ID:          m.ID,
+			Title:       title,
+			Overview:    ptr(overview),
+			ReleaseDate: optional(m.ReleaseDate),
+			VoteAverage: ptr(m.VoteAverage),
+			Popularity:  ptr(m.Popularity),
+			Score:       score,
+		})
+	}
+
+	matches = rankSearch(matches, after)
+	result := append([]model.MovieSearchItem{}, paginate(matches, offset, pageSize+1)...)
+	return len(matches), result, nil
+}
+
+// searchKey is the decoded (score, id) position of a search cursor.
+type searchKey struct {
+	score float64
+	id    string
+}
+
+func searchAfter(cursor, sort string) (*searchKey, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	c, err := pagination.DecodeFor(cursor, sort)
+	if err != nil {
+		return nil, err
+	}
+	if c.Value == nil {
+		return nil, pagination.ErrInvalidCursor
+	}
+	score, err := strconv.ParseFloat(*c.Value, 64)
+	if err != nil {
+		return nil, pagination.ErrInvalidCursor
+	}
+	return &searchKey{score: score, id: c.ID}, nil
+}
+
+// rankSearch orders matches by score DESC, id DESC and, with a cursor, drops
+// everything up to and including the cursor row.
+func rankSearch(items []model.MovieSearchItem, after *searchKey) []model.MovieSearchItem {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.ID.String() > b.ID.String()
+	})
+	if after == nil {
+		return items
+	}
+	res := items[:0]
+	for _, it := range items {
+		if it.Score < after.score || (it.Score == after.score && it.ID.String() < after.id) {
+			res = append(res, it)
+		}
+	}
+	return res
+}
+
+// searchFilter applies the include_adult, year and region filters shared by
+// SearchMovie and FuzzySearchMovie.
+func (r *Memory_repo) searchFilter(m fixtures.Movie, adult bool, primaryYear sql.NullInt64, region sql.NullString) bool {
+	if !adult && m.Adult {
+		return false
+	}
+	if primaryYear.Valid && !strings.HasPrefix(m.ReleaseDate, strconv.FormatInt(primaryYear.Int64, 10)+"-") {
+		return false
+	}
+	if region.Valid && !r.hasCompanyFrom(m, region.String) {
+		return false
+	}
+	return true
+}
+
+func (r *Memory_repo) hasCompanyFrom(m fixtures.Movie, country string) bool {
+	for _, cid := range m.CompanyIDs {
+		if r.companies[cid].OriginCountry == country {
+			return true
+		}
+	}
+	return false
+}
+
+func paginate[T any](items []T, offset, limit int) []T {
+	if offset >= len(items) {
+		return nil
+	}
+	end := offset + limit
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[offset:end]
+}
@@ -0,0 +1,98 @@
+package memoryrepo
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+)
+
+// A small stand-in for the PostgreSQL full-text search used by
+// movierepo.SearchMovie: documents and queries are split into lowercase
+// words, stop words are dropped and every remaining query word has to occur
+// in the document. There is no stemming, so it is stricter than tsvector.
+
+// Field weights match setweight A/B/C and ts_rank's default {0.1,0.2,0.4,1.0}.
+const (
+	weightA = 1.0
+	weightB = 0.4
+	weightC = 0.2
+)
+
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "by": true, "for": true, "from": true, "in": true, "is": true,
+	"it": true, "its": true, "of": true, "on": true, "or": true, "that": true,
+	"the": true, "to": true, "was": true, "with": true,
+}
+
+type weightedText struct {
+	text   string
+	weight float64
+}
+
+func tokenize(s string) []string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	res := words[:0]
+	for _, w := range words {
+		if !stopWords[w] {
+			res = append(res, w)
+		}
+	}
+	return res
+}
+
+// textRank returns the normalised rank (rank / (rank + 1), like ts_rank's
+// normalisation 32) of the query terms against the weighted fields, and
+// whether every term matched.
+func textRank(terms []string, fields []weightedText) (float64, bool) {
+	if len(terms) == 0 {
+		return 0, false
+	}
+
+	fieldWords := make([]map[string]bool, len(fields))
+	for i, f := range fields {
+		fieldWords[i] = make(map[string]bool)
+		for _, w := range tokenize(f.text) {
+			fieldWords[i][w] = true
+		}
+	}
+
+	rank := 0.0
+	for _, term := range terms {
+		best := 0.0
+		for i, f := range fields {
+			if fieldWords[i][term] && f.weight > best {
+				best = f.weight
+			}
+		}
+		if best == 0 {
+			return 0, false
+		}
+		rank += best
+	}
+	rank = rank / float64(len(terms)) * 0.6
+	return rank / (rank + 1), true
+}
+
+// searchScore mirrors the score column of movierepo.SearchMovie. ok is false
+// when neither the base movie nor its translation in lang matches.
+func (r *Memory_repo) searchScore(m fixtures.Movie, terms []string, lang string) (float64, bool) {
+	rank, ok := textRank(terms, []weightedText{
+		{m.Title, weightA}, {m.OriginalTitle, weightA}, {m.TagLine, weightB}, {m.Overview, weightC},
+	})
+	if t, found := r.translations[m.ID][lang]; found {
+		if tRank, tOK := textRank(terms, []weightedText{{t.Title, weightA}, {t.Overview, weightC}}); tOK {
+			ok = true
+			if tRank > rank {
+				rank = tRank
+			}
+		}
+	}
+	if !ok {
+		return 0, false
+	}
+	return 0.8*rank + 0.2*m.Popularity/(m.Popularity+100), true
+}
@@ -0,0 +1,146 @@
+package memoryrepo
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Memory_repo) UpsertMovies(ctx context.Context, movies []model.ImportMovie) ([]bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// names aren't unique: like DISTINCT ON (name) ... ORDER BY name, id the
+	// lowest id wins
+	genres := make(map[string]uuid.UUID)
+	for id, g := range r.genres {
+		if have, ok := genres[g.Name]; !ok || id.String() < have.String() {
+			genres[g.Name] = id
+		}
+	}
+	companies := make(map[string]uuid.UUID)
+	for id, c := range r.companies {
+		if have, ok := companies[c.Name]; !ok || id.String() < have.String() {
+			companies[c.Name] = id
+		}
+	}
+	people := make(map[string]uuid.UUID)
+	for id, p := range r.people {
+		if have, ok := people[p.Name]; !ok || id.String() < have.String() {
+			people[p.Name] = id
+		}
+	}
+
+	inserted := make([]bool, len(movies))
+	now := time.Now()
+	for i, im := range movies {
+		id, exists := r.externalIDs[im.ExternalID]
+		if !exists {
+			id = newID()
+			r.externalIDs[im.ExternalID] = id
+			r.movieOrder = append(r.movieOrder, id)
+		}
+		inserted[i] = !exists
+
+		m := fixtures.Movie{
+			ID:               id,
+			ExternalID:       im.ExternalID,
+			Title:            im.Title,
+			OriginalTitle:    orEmpty(im.OriginalTitle),
+			OriginalLanguage: orEmpty(im.OriginalLanguage),
+			TagLine:          orEmpty(im.TagLine),
+			Overview:         orEmpty(im.Overview),
+			ReleaseDate:      orEmpty(im.ReleaseDate),
+			Adult:            im.Adult,
+			Homepage:         orEmpty(im.Homepage),
+			PosterPath:       orEmpty(im.PosterPath),
+			BackdropPath:     orEmpty(im.BackdropPath),
+			Budget:           im.Budget,
+			Revenue:          im.Revenue,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+			Popularity:       im.Popularity,
+			BasePopularity:   im.Popularity,
+			VoteAverage:      im.VoteAverage,
+			VoteCount:        im.VoteCount,
+			SpokenLanguages:  append([]string(nil), im.SpokenLanguages...),
+		}
+		if exists {
+			// ON CONFLICT keeps the created_at and the vote columns
+			old := r.movies[id]
+			m.CreatedAt = old.CreatedAt
+			m.VoteAverage, m.VoteCount = old.VoteAverage, old.VoteCount
+		}
+		if im.Runtime != nil {
+			m.Runtime = *im.Runtime
+		}
+		for _, name := range im.Genres {
+			gid, ok := genres[name]
+			if !ok {
+				gid = newID()
+				genres[name] = gid
+				r.genres[gid] = fixtures.Genre{ID: gid, Name: name}
+			}
+			if !slices.Contains(m.GenreIDs, gid) {
+				m.GenreIDs = append(m.GenreIDs, gid)
+			}
+		}
+		for _, name := range im.Companies {
+			cid, ok := companies[name]
+			if !ok {
+				cid = newID()
+				companies[name] = cid
+				r.companies[cid] = fixtures.Company{ID: cid, Name: name}
+			}
+			if !slices.Contains(m.CompanyIDs, cid) {
+				m.CompanyIDs = append(m.CompanyIDs, cid)
+			}
+		}
+		r.movies[id] = m
+
+		// an update replaces every child row
+		r.credits[id] = nil
+		for _, c := range im.Credits {
+			pid, ok := people[c.Name]
+			if !ok {
+				pid = newID()
+				people[c.Name] = pid
+				r.people[pid] = fixtures.Person{ID: pid, Name: c.Name, KnownFor: orEmpty(c.KnownFor), ProfilePath: orEmpty(c.ProfilePath)}
+			}
+			r.credits[id] = append(r.credits[id], fixtures.Credit{
+				ID: newID(), MovieID: id, PersonID: pid, CreditType: c.CreditType,
+				Department: c.Department, Job: c.Job, CharacterName: c.Character, CastOrder: c.CastOrder,
+			})
+		}
+		r.images[id] = nil
+		for _, img := range im.Images {
+			r.images[id] = append(r.images[id], fixtures.Image{
+				ID: newID(), MovieID: id, FilePath: img.FilePath, Type: img.Type,
+				Width: img.Width, Height: img.Height, Language: img.Language,
+			})
+		}
+		r.translations[id] = make(map[string]fixtures.Translation)
+		for _, t := range im.Translations {
+			r.translations[id][t.Language] = fixtures.Translation{
+				MovieID: id, Language: t.Language, Title: orEmpty(t.Title), Overview: orEmpty(t.Overview),
+			}
+		}
+	}
+	return inserted, nil
+}
+
+func newID() uuid.UUID {
+	return uuid.Must(uuid.NewV7())
+}
+
+// orEmpty is the inverse of optional.
+func orEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,48 @@
+package memoryrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	userrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/user_repo"
+)
+
+func (r *Memory_repo) CreateUser(ctx context.Context, u model.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.userEmails[u.Email]; ok {
+		return userrepo.ErrEmailTaken
+	}
+	if _, ok := r.users[u.ID]; ok {
+		return fmt.Errorf("Error Query CreateUser: user %s already exists", u.ID)
+	}
+	r.users[u.ID] = u
+	r.userEmails[u.Email] = u.ID
+	return nil
+}
+
+func (r *Memory_repo) GetUserByEmail(ctx context.Context, email string) (model.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id, ok := r.userEmails[email]
+	if !ok {
+		return model.User{}, fmt.Errorf("Query GetUserByEmail: %w", sql.ErrNoRows)
+	}
+	return r.users[id], nil
+}
+
+func (r *Memory_repo) GetUserById(ctx context.Context, id uuid.UUID) (model.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u, ok := r.users[id]
+	if !ok {
+		return model.User{}, fmt.Errorf("Query GetUserById: %w", sql.ErrNoRows)
+	}
+	return u, nil
+}
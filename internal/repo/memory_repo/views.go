@@ -0,0 +1,120 @@
+package memoryrepo
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	viewrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/view_repo"
+)
+
+func (r *Memory_repo) RecordViews(ctx context.Context, views []model.MovieViews) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, v := range views {
+		if _, ok := r.movies[v.MovieID]; !ok {
+			continue
+		}
+		if r.views[v.MovieID] == nil {
+			r.views[v.MovieID] = make(map[time.Time]int)
+		}
+		r.views[v.MovieID][v.Hour.UTC()] += v.Views
+	}
+	return nil
+}
+
+func (r *Memory_repo) FetchTrending(ctx context.Context, w viewrepo.Window, lang string, includeAdult bool, page, pageSize int) ([]model.DiscoverItem, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	type scored struct {
+		m     fixtures.Movie
+		score float64
+	}
+	var matches []scored
+	for movieID, hours := range r.views {
+		score, ok := windowScore(hours, w)
+		if !ok {
+			continue
+		}
+		m := r.movies[movieID]
+		if m.Adult && !includeAdult {
+			continue
+		}
+		matches = append(matches, scored{m: m, score: score})
+	}
+
+	// ORDER BY s.score DESC, ms.popularity DESC NULLS LAST, m.id
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		if a.m.Popularity != b.m.Popularity {
+			return a.m.Popularity > b.m.Popularity
+		}
+		return a.m.ID.String() < b.m.ID.String()
+	})
+
+	items := []model.DiscoverItem{}
+	for _, s := range paginate(matches, offset, pageSize) {
+		items = append(items, r.discoverItem(s.m, lang))
+	}
+	return items, len(matches), nil
+}
+
+func (r *Memory_repo) RefreshPopularity(ctx context.Context, w viewrepo.Window) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// UPDATE movie_stats SET popularity = base_popularity + score, viewed or
+	// not, WHERE that IS DISTINCT FROM popularity
+	updated := 0
+	for movieID, m := range r.movies {
+		score, _ := windowScore(r.views[movieID], w)
+		if p := m.BasePopularity + score; p != m.Popularity {
+			m.Popularity = p
+			r.movies[movieID] = m
+			updated++
+		}
+	}
+
+	// DELETE FROM movie_views WHERE hour < $1
+	for movieID, hours := range r.views {
+		for hour := range hours {
+			if hour.Before(w.Since) {
+				delete(hours, hour)
+			}
+		}
+		if len(hours) == 0 {
+			delete(r.views, movieID)
+		}
+	}
+	return updated, nil
+}
+
+// windowScore mirrors the decayed SUM over a movie's hours in the window,
+// and reports whether any of them are.
+func windowScore(hours map[time.Time]int, w viewrepo.Window) (float64, bool) {
+	score, in := 0.0, false
+	for hour, views := range hours {
+		if hour.Before(w.Since) {
+			continue
+		}
+		score += float64(views) * math.Pow(0.5, w.Now.Sub(hour).Seconds()/w.HalfLife.Seconds())
+		in = true
+	}
+	return score, in
+}
@@ -0,0 +1,88 @@
+package memoryrepo
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// applyMovieWrite copies w onto m. With replace set a nil field is reset to
+// its column default, mirroring ReplaceMovie / CreateMovie; otherwise it is
+// kept, mirroring PatchMovie's COALESCE.
+func applyMovieWrite(m *fixtures.Movie, w model.MovieWrite, replace bool) {
+	setString(&m.Title, w.Title, replace)
+	setString(&m.OriginalTitle, w.OriginalTitle, replace)
+	setString(&m.OriginalLanguage, w.OriginalLanguage, replace)
+	setString(&m.TagLine, w.TagLine, replace)
+	setString(&m.Overview, w.Overview, replace)
+	setString(&m.ReleaseDate, w.ReleaseDate, replace)
+	setValue(&m.Runtime, w.Runtime, replace)
+	setValue(&m.Adult, w.Adult, replace)
+	setString(&m.Homepage, w.Homepage, replace)
+	setString(&m.PosterPath, w.PosterPath, replace)
+	setString(&m.BackdropPath, w.BackdropPath, replace)
+	setValue(&m.Budget, w.Budget, replace)
+	setValue(&m.Revenue, w.Revenue, replace)
+	setValue(&m.Popularity, w.Popularity, replace)
+	setValue(&m.BasePopularity, w.Popularity, replace)
+
+	if w.GenreIDs != nil || replace {
+		m.GenreIDs = append([]uuid.UUID(nil), deref(w.GenreIDs)...)
+	}
+	if w.CompanyIDs != nil || replace {
+		m.CompanyIDs = append([]uuid.UUID(nil), deref(w.CompanyIDs)...)
+	}
+	if w.SpokenLanguages != nil || replace {
+		m.SpokenLanguages = append([]string(nil), deref(w.SpokenLanguages)...)
+	}
+	m.UpdatedAt = time.Now()
+}
+
+// checkMovieLinks reports links to genres, companies or languages that don't
+// exist, as the foreign keys on the link tables would.
+func (r *Memory_repo) checkMovieLinks(w model.MovieWrite) error {
+	for _, id := range deref(w.GenreIDs) {
+		if _, ok := r.genres[id]; !ok {
+			return &model.ValidationError{Errors: []model.FieldError{{Field: "genre_ids", Message: "references an unknown genre"}}}
+		}
+	}
+	for _, id := range deref(w.CompanyIDs) {
+		if _, ok := r.companies[id]; !ok {
+			return &model.ValidationError{Errors: []model.FieldError{{Field: "company_ids", Message: "references an unknown company"}}}
+		}
+	}
+	for _, iso := range deref(w.SpokenLanguages) {
+		if _, ok := r.language(iso); !ok {
+			return &model.ValidationError{Errors: []model.FieldError{{Field: "spoken_languages", Message: "references an unknown language"}}}
+		}
+	}
+	return nil
+}
+
+func setString(dst *string, v *string, replace bool) {
+	switch {
+	case v != nil:
+		*dst = *v
+	case replace:
+		*dst = ""
+	}
+}
+
+func setValue[T any](dst *T, v *T, replace bool) {
+	switch {
+	case v != nil:
+		*dst = *v
+	case replace:
+		var zero T
+		*dst = zero
+	}
+}
+
+func deref[T any](s *[]T) []T {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
@@ -1,6 +1,10 @@
 package movierepo
 
-import "database/sql"
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
 
 type Movie_repo struct {
 	db *sql.DB
@@ -9,3 +13,20 @@ type Movie_repo struct {
 func New_Movie_Repo(db *sql.DB) *Movie_repo {
 	return &Movie_repo{db: db}
 }
+
+// inTx runs fn in a transaction, committing only if it returns nil.
+func (r Movie_repo) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Error begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("Error commit tx: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package movierepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r Movie_repo) CreateMovie(ctx context.Context, id uuid.UUID, w model.MovieWrite) error {
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		query := `INSERT INTO movies (
+		            id, title, original_title, original_language, tag_line, overview,
+		            release_date, runtime, adult, homepage, poster_path, backdrop_path, budget, revenue
+		          ) VALUES ($1, $2, $3, $4, $5, $6, $7::date, $8, COALESCE($9, FALSE), $10, $11, $12, COALESCE($13, 0), COALESCE($14, 0))`
+
+		_, err := tx.ExecContext(ctx, query, id, w.Title, w.OriginalTitle, w.OriginalLanguage, w.TagLine, w.Overview,
+			w.ReleaseDate, w.Runtime, w.Adult, w.Homepage, w.PosterPath, w.BackdropPath, w.Budget, w.Revenue)
+		if err != nil {
+			return fmt.Errorf("Error Query CreateMovie: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO movie_stats (movie_id, popularity, base_popularity)
+		          VALUES ($1, COALESCE($2, 0), COALESCE($2, 0))`,
+			id, w.Popularity)
+		if err != nil {
+			return fmt.Errorf("Error Query CreateMovie stats: %w", err)
+		}
+
+		return writeMovieLinks(ctx, tx, id.String(), w, true)
+	})
+}
@@ -0,0 +1,15 @@
+package movierepo
+
+import (
+	"context"
+	"fmt"
+)
+
+// DeleteMovie removes a movie; every table referencing it cascades.
+func (r Movie_repo) DeleteMovie(ctx context.Context, id string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM movies WHERE id = $1`, id)
+	if err := updated(res, err); err != nil {
+		return fmt.Errorf("Error Query DeleteMovie: %w", err)
+	}
+	return nil
+}
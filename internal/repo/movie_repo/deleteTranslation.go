@@ -0,0 +1,23 @@
+package movierepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// DeleteTranslation removes the movie's translation into lang. A movie
+// without one returns an error wrapping sql.ErrNoRows.
+func (r Movie_repo) DeleteTranslation(ctx context.Context, id, lang string) error {
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, `DELETE FROM movie_translations WHERE movie_id = $1 AND language = $2`, id, lang)
+		if err := updated(res, err); err != nil {
+			return fmt.Errorf("Error Query DeleteTranslation: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE movies SET updated_at = now() WHERE id = $1`, id); err != nil {
+			return fmt.Errorf("Error Query DeleteTranslation touch movie: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,83 @@
+package movierepo
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/lib/pq"
+)
+
+// DiscoverItemColumns selects a model.DiscoverItem and the total number of
+// rows, for ScanDiscoverItems. It expects movies as m and the LEFT JOINed
+// movie_translations and movie_stats as mt and ms.
+const DiscoverItemColumns = `
+      m.id,
+      COALESCE(mt.title,m.title) AS title,
+      COALESCE(mt.overview,m.overview) AS overview,
+      to_char(m.release_date, 'YYYY-MM-DD') AS release_date,
+      ms.vote_average, ms.vote_count,
+      m.poster_path, m.backdrop_path, ms.popularity,
+      (SELECT COALESCE(array_agg(mg.genre_id::text), ARRAY[]::text[])
+         FROM movie_genres mg
+         WHERE mg.movie_id = m.id
+      ) AS genre_ids,
+      COUNT(*) OVER() AS total_count`
+
+// ScanDiscoverItems reads the rows of a query selecting DiscoverItemColumns
+// and returns the items with the total count. It closes rows.
+func ScanDiscoverItems(rows *sql.Rows) ([]model.DiscoverItem, int, error) {
+	defer rows.Close()
+
+	items := []model.DiscoverItem{}
+	totalCount := 0
+
+	for rows.Next() {
+		var (
+			it          model.DiscoverItem
+			overview    sql.NullString
+			releaseDate sql.NullString
+			voteAvg     sql.NullFloat64
+			voteCount   sql.NullInt64
+			poster      sql.NullString
+			backdrop    sql.NullString
+			popularity  sql.NullFloat64
+			genreIDs    pq.StringArray
+		)
+
+		if err := rows.Scan(&it.ID, &it.Title, &overview, &releaseDate, &voteAvg, &voteCount, &poster, &backdrop, &popularity, &genreIDs, &totalCount); err != nil {
+			return nil, 0, fmt.Errorf("Error discover item row scan: %w", err)
+		}
+
+		if overview.Valid {
+			it.Overview = &overview.String
+		}
+		if releaseDate.Valid {
+			it.ReleaseDate = &releaseDate.String
+		}
+		if voteAvg.Valid {
+			it.VoteAverage = &voteAvg.Float64
+		}
+		if voteCount.Valid {
+			vc := int(voteCount.Int64)
+			it.VoteCount = &vc
+		}
+		if poster.Valid {
+			it.PosterPath = &poster.String
+		}
+		if backdrop.Valid {
+			it.BackdropPath = &backdrop.String
+		}
+		if popularity.Valid {
+			it.Popularity = &popularity.Float64
+		}
+		it.GenreIDs = []string(genreIDs)
+
+		items = append(items, it)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("Error discover item rows: %w", err)
+	}
+	return items, totalCount, nil
+}
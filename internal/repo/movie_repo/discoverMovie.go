@@ -8,9 +8,21 @@ import (
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/h-raju-arch/movie_app_backend/internal/pagination"
 	"github.com/lib/pq"
 )
 
+// discoverSortColumns maps model.DiscoverMoviesParams.SortKey fields to their
+// column and the SQL type a cursor value is cast to.
+var discoverSortColumns = map[string]struct{ col, typ string }{
+	"popularity":   {"ms.popularity", "double precision"},
+	"release_date": {"m.release_date", "date"},
+	"vote_average": {"ms.vote_average", "double precision"},
+}
+
+// DiscoverMovies returns up to PageSize+1 rows: the extra row only tells the
+// caller that another page exists. With a Cursor the page starts right after
+// the cursor row (keyset pagination) and the total count is not computed.
 func (r Movie_repo) DiscoverMovies(ctx context.Context, p model.DiscoverMoviesParams) ([]model.DiscoverItem, int, error) {
 	if p.Page < 1 {
 		p.Page = 1
@@ -20,6 +32,17 @@ func (r Movie_repo) DiscoverMovies(ctx context.Context, p model.DiscoverMoviesPa
 	}
 	offset := (p.Page - 1) * p.PageSize
 
+	field, dir := p.SortKey()
+	var after *pagination.Cursor
+	if p.Cursor != "" {
+		c, err := pagination.DecodeFor(p.Cursor, field+"."+dir)
+		if err != nil {
+			return nil, 0, fmt.Errorf("Discover movies cursor: %w", err)
+		}
+		after = &c
+		offset = 0
+	}
+
 	// --------- SELECT (fixed ARRAY[]::text[] and corrected correlated subquery mg.movie_id = m.id)
 	BaseQuery := `
     SELECT 
@@ -33,8 +56,14 @@ func (r Movie_repo) DiscoverMovies(ctx context.Context, p model.DiscoverMoviesPa
          FROM movie_genres mg JOIN genres g ON mg.genre_id = g.id 
          WHERE mg.movie_id = m.id
       ) AS genre_ids,
-      COUNT(*) OVER() AS total_count
+      %s AS total_count
   `
+	// counting every match defeats the point of a cursor, so skip it there
+	if after != nil {
+		BaseQuery = fmt.Sprintf(BaseQuery, "NULL::bigint")
+	} else {
+		BaseQuery = fmt.Sprintf(BaseQuery, "COUNT(*) OVER()")
+	}
 
 	fromWhere := `FROM movies m 
     LEFT JOIN movie_translations mt ON mt.movie_id = m.id AND mt.language = $1 
@@ -91,27 +120,30 @@ func (r Movie_repo) DiscoverMovies(ctx context.Context, p model.DiscoverMoviesPa
 		}
 	}
 
-	// ordering (kept your logic, with corrected column names)
-	orderBy := "ms.popularity DESC NULLS LAST, m.created_at DESC"
-	if p.SortBy != "" {
-		parts := strings.SplitN(p.SortBy, ".", 2)
-		sortMap := map[string]string{
-			"popularity":   "ms.popularity",
-			"release_date": "m.release_date",
-			"vote_average": "ms.vote_average",
-		}
-		field := parts[0]
-		dir := "desc"
-		if len(parts) == 2 && (strings.ToLower(parts[1]) == "asc" || strings.ToLower(parts[1]) == "desc") {
-			dir = strings.ToLower(parts[1])
+	// ordering: the sort column, then m.id in the same direction so rows with
+	// equal values keep a stable order across pages
+	sortCol := discoverSortColumns[field]
+	orderBy := fmt.Sprintf("%s %s NULLS LAST, m.id %s", sortCol.col, dir, dir)
+
+	if after != nil {
+		cmp := "<"
+		if dir == "asc" {
+			cmp = ">"
 		}
-		if col, ok := sortMap[field]; ok {
-			orderBy = fmt.Sprintf("%s %s NULLS LAST", col, dir)
+		idPos := addArg(after.ID)
+		if after.Value != nil {
+			// NULLS LAST: once past the non-null values, every NULL row follows
+			valPos := addArg(*after.Value)
+			where = append(where, fmt.Sprintf(
+				"((%[1]s IS NOT NULL AND (%[1]s %[2]s %[3]s::%[4]s OR (%[1]s = %[3]s::%[4]s AND m.id %[2]s %[5]s::uuid))) OR %[1]s IS NULL)",
+				sortCol.col, cmp, valPos, sortCol.typ, idPos))
+		} else {
+			where = append(where, fmt.Sprintf("(%s IS NULL AND m.id %s %s::uuid)", sortCol.col, cmp, idPos))
 		}
 	}
 
-	// limit & offset
-	limitPlaceholder := addArg(p.PageSize)
+	// limit & offset (one extra row to detect a next page)
+	limitPlaceholder := addArg(p.PageSize + 1)
 	offsetPlaceholder := addArg(offset)
 
 	sqlStr := fmt.Sprintf("%s %s WHERE %s ORDER BY %s LIMIT %s OFFSET %s",
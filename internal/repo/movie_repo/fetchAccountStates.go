@@ -0,0 +1,25 @@
+package movierepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// FetchAccountStates returns whether the movie is on userID's watchlist and
+// favorites, and their rating of it.
+func (r Movie_repo) FetchAccountStates(ctx context.Context, id string, userID uuid.UUID) (model.AccountStates, error) {
+	query := `SELECT
+	            EXISTS (SELECT 1 FROM watchlist WHERE user_id = $2 AND movie_id = $1),
+	            EXISTS (SELECT 1 FROM favorites WHERE user_id = $2 AND movie_id = $1),
+	            (SELECT value FROM ratings WHERE user_id = $2 AND movie_id = $1)`
+
+	var res model.AccountStates
+	if err := r.db.QueryRowContext(ctx, query, id, userID).Scan(&res.Watchlist, &res.Favorite, &res.Rated); err != nil {
+		return res, fmt.Errorf("Error Query FetchAccountStates: %w", err)
+	}
+	res.ID = uuid.FromStringOrNil(id)
+	return res, nil
+}
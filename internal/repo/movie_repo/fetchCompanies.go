@@ -3,12 +3,15 @@ package movierepo
 import (
 	"context"
 	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
 )
 
-func (r Movie_repo) FetchCompanies(ctx context.Context, id string) ([]string, error) {
+func (r Movie_repo) FetchCompanies(ctx context.Context, id string) ([]model.ProductionCompany, error) {
 
-	query := `SELECT c.name from companies c join movie_companies mc on
-	         c.id = mc.company_id WHERE mc.movie_id = $1`
+	query := `SELECT c.id, c.name, c.origin_country from companies c join movie_companies mc on
+	         c.id = mc.company_id WHERE mc.movie_id = $1
+	         ORDER BY c.name`
 
 	rows, err := r.db.QueryContext(ctx, query, id)
 
@@ -17,15 +20,15 @@ func (r Movie_repo) FetchCompanies(ctx context.Context, id string) ([]string, er
 	}
 
 	defer rows.Close()
-	var resp []string
+	var resp []model.ProductionCompany
 
 	for rows.Next() {
-		var name string
-		err := rows.Scan(&name)
+		var c model.ProductionCompany
+		err := rows.Scan(&c.ID, &c.Name, &c.OriginCountry)
 		if err != nil {
 			return nil, fmt.Errorf("Error Fetch Comapanies scan: %w", err)
 		}
-		resp = append(resp, name)
+		resp = append(resp, c)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -7,29 +7,35 @@ import (
 	"github.com/h-raju-arch/movie_app_backend/internal/model"
 )
 
-func (r Movie_repo) FetchCredits(ctx context.Context, id string) ([]model.Credits_Response, error) {
-	query := `SELECT p.name,p.known_for,c.credit_type
-	          FROM people p JOIN credits c on p.id = c.person_id
-			  WHERE c.movie_id = $1
-	`
+// FetchCredits returns the cast, in cast_order, followed by the crew ordered
+// by department and job.
+func (r Movie_repo) FetchCredits(ctx context.Context, id string) ([]model.MovieCredit, error) {
+	query := `SELECT c.id, p.id, p.name, p.known_for, p.profile_path,
+	            c.credit_type, c.character_name, c.cast_order, c.department, c.job
+	          FROM credits c
+	          JOIN people p ON p.id = c.person_id
+	          WHERE c.movie_id = $1
+	          ORDER BY c.credit_type, c.cast_order ASC NULLS LAST, c.department, c.job, p.name, c.id`
+
 	rows, err := r.db.QueryContext(ctx, query, id)
 	if err != nil {
-		return []model.Credits_Response{}, fmt.Errorf("Query FetchCredits : %w", err)
+		return []model.MovieCredit{}, fmt.Errorf("Query FetchCredits : %w", err)
 	}
 	defer rows.Close()
 
-	var resp []model.Credits_Response
+	resp := []model.MovieCredit{}
 	for rows.Next() {
-		var temp model.Credits_Response
-		err := rows.Scan(&temp.Name, &temp.Known_for, &temp.Credit_type)
+		var temp model.MovieCredit
+		err := rows.Scan(&temp.CreditID, &temp.ID, &temp.Name, &temp.KnownFor, &temp.ProfilePath,
+			&temp.CreditType, &temp.Character, &temp.CastOrder, &temp.Department, &temp.Job)
 		if err != nil {
-			return []model.Credits_Response{}, fmt.Errorf("Error Credit rows scan: %w", err)
+			return []model.MovieCredit{}, fmt.Errorf("Error Credit rows scan: %w", err)
 		}
 		resp = append(resp, temp)
 	}
 
 	if err := rows.Err(); err != nil {
-		return []model.Credits_Response{}, fmt.Errorf("Error Fetch Credits row: %w", err)
+		return []model.MovieCredit{}, fmt.Errorf("Error Fetch Credits row: %w", err)
 	}
 	return resp, nil
 }
@@ -3,14 +3,22 @@ package movierepo
 import (
 	"context"
 	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
 )
 
-func (r Movie_repo) FetchGenres(ctx context.Context, id string) ([]string, error) {
+// FetchGenres returns the movie's genres named in lang, falling back to the
+// English name for genres without a translation.
+func (r Movie_repo) FetchGenres(ctx context.Context, id, lang string) ([]model.Genre, error) {
 
-	var res []string
-	query := `SELECT g.name FROM genres g JOIN movie_genres mg ON g.id = mg.genre_id  WHERE mg.movie_id = $1`
+	var res []model.Genre
+	query := `SELECT g.id, COALESCE(gt.name, g.name) AS name
+	          FROM genres g JOIN movie_genres mg ON g.id = mg.genre_id
+	          LEFT JOIN genre_translations gt ON gt.genre_id = g.id AND gt.language = $2
+	          WHERE mg.movie_id = $1
+	          ORDER BY name, g.id`
 
-	rows, err := r.db.QueryContext(ctx, query, id)
+	rows, err := r.db.QueryContext(ctx, query, id, lang)
 
 	if err != nil {
 		return nil, fmt.Errorf("Error Query FetchGenres: %w", err)
@@ -18,12 +26,12 @@ func (r Movie_repo) FetchGenres(ctx context.Context, id string) ([]string, error
 	defer rows.Close()
 
 	for rows.Next() {
-		var name string
-		err := rows.Scan(&name)
+		var g model.Genre
+		err := rows.Scan(&g.ID, &g.Name)
 		if err != nil {
 			return nil, fmt.Errorf("Erro Fetch Genres row scan: %w", err)
 		}
-		res = append(res, name)
+		res = append(res, g)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -0,0 +1,42 @@
+package movierepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/lib/pq"
+)
+
+// FetchImages returns the movie's images matching filter, widest first
+// within each type.
+func (r Movie_repo) FetchImages(ctx context.Context, id string, filter model.LanguageFilter) ([]model.Image, error) {
+	query := `SELECT i.type, COALESCE(i.file_path, ''), COALESCE(i.width, 0), COALESCE(i.height, 0), i.language
+	          FROM images i
+	          WHERE i.movie_id = $1
+	            AND (NOT $2 OR i.language = ANY($3::text[]) OR ($4 AND i.language IS NULL))
+	          ORDER BY i.type, i.width DESC NULLS LAST, i.id`
+
+	rows, err := r.db.QueryContext(ctx, query, id, filter.Active(), pq.Array(filter.Languages), filter.IncludeNull)
+	if err != nil {
+		return nil, fmt.Errorf("Error Query FetchImages: %w", err)
+	}
+	defer rows.Close()
+
+	var res []model.Image
+	for rows.Next() {
+		var img model.Image
+		if err := rows.Scan(&img.Type, &img.FilePath, &img.Width, &img.Height, &img.Language); err != nil {
+			return nil, fmt.Errorf("Error Fetch Images row scan: %w", err)
+		}
+		if img.Height > 0 {
+			img.AspectRatio = float64(img.Width) / float64(img.Height)
+		}
+		res = append(res, img)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Error Fetch Images row: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,55 @@
+package movierepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r Movie_repo) FetchReviews(ctx context.Context, id string, viewer uuid.UUID, page, pageSize int) ([]model.Review, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	// no review has the nil author, so anonymous viewers only see approved ones
+	query := `SELECT id, movie_id, author_id, rating, content, status, created_at, updated_at,
+	            COUNT(*) OVER() AS total_count
+	          FROM reviews
+	          WHERE movie_id = $1 AND (status = 'approved' OR author_id = $2)
+	          ORDER BY created_at DESC, id
+	          LIMIT $3 OFFSET $4`
+
+	rows, err := r.db.QueryContext(ctx, query, id, viewer, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error Query FetchReviews: %w", err)
+	}
+	defer rows.Close()
+
+	res := []model.Review{}
+	totalCount := 0
+	for rows.Next() {
+		var (
+			rv     model.Review
+			rating sql.NullFloat64
+		)
+		if err := rows.Scan(&rv.ID, &rv.MovieID, &rv.AuthorID, &rating, &rv.Content, &rv.Status, &rv.CreatedAt, &rv.UpdatedAt, &totalCount); err != nil {
+			return nil, 0, fmt.Errorf("Error Fetch Reviews row scan: %w", err)
+		}
+		if rating.Valid {
+			rv.Rating = &rating.Float64
+		}
+		res = append(res, rv)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("Error Fetch Reviews row: %w", err)
+	}
+	return res, totalCount, nil
+}
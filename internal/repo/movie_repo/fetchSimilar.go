@@ -0,0 +1,71 @@
+package movierepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// Weights of each thing a movie shares with the one FetchSimilar is asked
+// about. A movie's score is the sum over everything it shares.
+const (
+	SimilarGenreWeight    = 1.0 // per genre
+	SimilarCompanyWeight  = 1.0 // per production company
+	SimilarCastWeight     = 1.5 // per top-billed actor in both
+	SimilarDirectorWeight = 3.0
+	SimilarComposerWeight = 2.0
+
+	// SimilarCastDepth is how far down the billing actors still count.
+	SimilarCastDepth = 10
+)
+
+func (r Movie_repo) FetchSimilar(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) ([]model.DiscoverItem, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	query := `
+    WITH overlap AS (
+      SELECT o.movie_id, $3::float8 AS weight
+        FROM movie_genres s JOIN movie_genres o ON o.genre_id = s.genre_id
+        WHERE s.movie_id = $1 AND o.movie_id <> $1
+      UNION ALL
+      SELECT o.movie_id, $4::float8
+        FROM movie_companies s JOIN movie_companies o ON o.company_id = s.company_id
+        WHERE s.movie_id = $1 AND o.movie_id <> $1
+      UNION ALL
+      SELECT o.movie_id, CASE WHEN s.credit_type = 'cast' THEN $5::float8 WHEN s.job = 'Director' THEN $6::float8 ELSE $7::float8 END
+        FROM credits s JOIN credits o ON o.person_id = s.person_id AND o.credit_type = s.credit_type
+        WHERE s.movie_id = $1 AND o.movie_id <> $1
+          AND ((s.credit_type = 'cast' AND s.cast_order <= $8 AND o.cast_order <= $8)
+            OR (s.credit_type = 'crew' AND s.job IN ('Director', 'Original Music Composer') AND o.job = s.job))
+    ),
+    scores AS (
+      SELECT movie_id, SUM(weight) AS score FROM overlap GROUP BY movie_id
+    )
+    SELECT` + DiscoverItemColumns + `
+    FROM scores s
+    JOIN movies m ON m.id = s.movie_id
+    LEFT JOIN movie_translations mt ON mt.movie_id = m.id AND mt.language = $2
+    LEFT JOIN movie_stats ms ON ms.movie_id = m.id
+    WHERE $9 OR m.adult = false
+    ORDER BY s.score DESC, ms.popularity DESC NULLS LAST, m.id
+    LIMIT $10 OFFSET $11`
+
+	rows, err := r.db.QueryContext(ctx, query, id, lang,
+		SimilarGenreWeight, SimilarCompanyWeight, SimilarCastWeight, SimilarDirectorWeight, SimilarComposerWeight, SimilarCastDepth,
+		includeAdult, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error Query FetchSimilar: %w", err)
+	}
+	items, totalCount, err := ScanDiscoverItems(rows)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error Query FetchSimilar: %w", err)
+	}
+	return items, totalCount, nil
+}
@@ -0,0 +1,35 @@
+package movierepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r Movie_repo) FetchSpokenLanguages(ctx context.Context, id string) ([]model.Language, error) {
+	query := `SELECT l.iso_639_1, COALESCE(l.name, ''), COALESCE(l.native_name, l.name, '')
+	          FROM movie_spoken_languages msl JOIN languages l ON l.iso_639_1 = msl.iso_639_1
+	          WHERE msl.movie_id = $1
+	          ORDER BY l.name`
+
+	rows, err := r.db.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("Error Query FetchSpokenLanguages: %w", err)
+	}
+	defer rows.Close()
+
+	var res []model.Language
+	for rows.Next() {
+		var l model.Language
+		if err := rows.Scan(&l.ISO6391, &l.EnglishName, &l.Name); err != nil {
+			return nil, fmt.Errorf("Error Fetch Spoken Languages row scan: %w", err)
+		}
+		res = append(res, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Error Fetch Spoken Languages row: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,38 @@
+package movierepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// FetchTranslations returns every translation of a movie, ordered by
+// language code.
+func (r Movie_repo) FetchTranslations(ctx context.Context, id string) ([]model.MovieTranslation, error) {
+	query := `SELECT mt.language, l.name, COALESCE(l.native_name, l.name), mt.title, mt.overview
+	          FROM movie_translations mt
+	          LEFT JOIN languages l ON l.iso_639_1 = mt.language
+	          WHERE mt.movie_id = $1
+	          ORDER BY mt.language`
+
+	rows, err := r.db.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("Error Query FetchTranslations: %w", err)
+	}
+	defer rows.Close()
+
+	res := []model.MovieTranslation{}
+	for rows.Next() {
+		var t model.MovieTranslation
+		if err := rows.Scan(&t.ISO6391, &t.EnglishName, &t.Name, &t.Data.Title, &t.Data.Overview); err != nil {
+			return nil, fmt.Errorf("Error Fetch Translations row scan: %w", err)
+		}
+		res = append(res, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Error Fetch Translations row: %w", err)
+	}
+	return res, nil
+}
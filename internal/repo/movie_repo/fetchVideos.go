@@ -0,0 +1,40 @@
+package movierepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/lib/pq"
+)
+
+// FetchVideos returns the movie's videos matching filter, official videos
+// first, then newest first.
+func (r Movie_repo) FetchVideos(ctx context.Context, id string, filter model.LanguageFilter) ([]model.Video, error) {
+	query := `SELECT v.id, v.site, v.key, v.name, v.type, v.official, v.language,
+	            to_char(v.published_at AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"')
+	          FROM videos v
+	          WHERE v.movie_id = $1
+	            AND (NOT $2 OR v.language = ANY($3::text[]) OR ($4 AND v.language IS NULL))
+	          ORDER BY v.official DESC, v.published_at DESC NULLS LAST, v.id`
+
+	rows, err := r.db.QueryContext(ctx, query, id, filter.Active(), pq.Array(filter.Languages), filter.IncludeNull)
+	if err != nil {
+		return nil, fmt.Errorf("Error Query FetchVideos: %w", err)
+	}
+	defer rows.Close()
+
+	var res []model.Video
+	for rows.Next() {
+		var v model.Video
+		if err := rows.Scan(&v.ID, &v.Site, &v.Key, &v.Name, &v.Type, &v.Official, &v.Language, &v.PublishedAt); err != nil {
+			return nil, fmt.Errorf("Error Fetch Videos row scan: %w", err)
+		}
+		res = append(res, v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Error Fetch Videos row: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,170 @@
+package movierepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/h-raju-arch/movie_app_backend/internal/pagination"
+)
+
+// FuzzySimilarityThreshold is the minimum pg_trgm similarity a title needs to
+// be returned by FuzzySearchMovie. It matches pg_trgm's default
+// similarity_threshold, which the % operator uses to pick candidates.
+const FuzzySimilarityThreshold = 0.3
+
+// FuzzySearchMovie matches titles by trigram similarity instead of words, so
+// misspelled queries ("interstelar") still find the movie. Results are ordered
+// by score, the best similarity of the localized or original title blended
+// with popularity. Paging works like SearchMovie.
+func (r Movie_repo) FuzzySearchMovie(ctx context.Context, queryStr string, adult bool, lang string, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	offset := (page - 1) * pageSize
+
+	afterScore, afterID, err := searchAfter(cursor, pagination.SortFuzzySearch)
+	if err != nil {
+		return 0, []model.MovieSearchItem{}, fmt.Errorf("Fuzzy search movie cursor: %w", err)
+	}
+	if afterID != nil {
+		offset = 0
+	}
+
+	var year interface{}
+	if primaryYear.Valid {
+		year = primaryYear.Int64
+	}
+
+	var regionParam interface{}
+	if region.Valid {
+		regionParam = region.String
+	}
+
+	// the IN (...) candidate list lets the trigram indexes do the first pass;
+	// the exact similarity is then computed on the localized title
+	query := `
+WITH ranked AS (
+  SELECT
+    m.id,
+    COALESCE(mt.title, m.title) AS title,
+    COALESCE(mt.overview, m.overview) AS overview,
+    to_char(m.release_date, 'YYYY-MM-DD') AS release_date,
+    ms.vote_average,
+    ms.popularity,
+    s.sim AS similarity,
+    (0.8 * s.sim + 0.2 * COALESCE(ms.popularity, 0) / (COALESCE(ms.popularity, 0) + 100))::double precision AS score
+  FROM movies m
+  LEFT JOIN movie_translations mt ON mt.movie_id = m.id AND mt.language = $2
+  LEFT JOIN movie_stats ms ON ms.movie_id = m.id
+  CROSS JOIN LATERAL (
+    SELECT GREATEST(
+      similarity(COALESCE(mt.title, m.title), $1),
+      similarity(COALESCE(m.original_title, ''), $1)
+    )::double precision AS sim
+  ) s
+  WHERE m.id IN (
+      SELECT mv.id FROM movies mv WHERE mv.title % $1 OR mv.original_title % $1
+      UNION
+      SELECT t.movie_id FROM movie_translations t WHERE t.language = $2 AND t.title % $1
+  )
+  AND s.sim >= $8
+  AND ($3 OR m.adult = false)
+  AND ($4::int IS NULL OR EXTRACT(YEAR FROM m.release_date)::int = $4::int)
+  AND (
+    $5::text IS NULL OR EXISTS (
+      SELECT 1
+      FROM movie_companies mc
+      JOIN companies c ON c.id = mc.company_id
+      WHERE mc.movie_id = m.id
+        AND c.origin_country = $5::text
+    )
+  )
+)
+SELECT id, title, overview, release_date, vote_average, popularity, similarity, score,
+  COUNT(*) OVER() AS total_count
+FROM ranked
+WHERE $9::uuid IS NULL OR (score, id) < ($10::double precision, $9::uuid)
+ORDER BY score DESC, id DESC
+LIMIT $6 OFFSET $7;
+`
+
+	rows, err := r.db.QueryContext(ctx, query,
+		queryStr,                 // $1
+		lang,                     // $2
+		adult,                    // $3
+		year,                     // $4
+		regionParam,              // $5
+		pageSize+1,               // $6 (one extra row to detect a next page)
+		offset,                   // $7
+		FuzzySimilarityThreshold, // $8
+		afterID,                  // $9
+		afterScore,               // $10
+	)
+	if err != nil {
+		return 0, []model.MovieSearchItem{}, fmt.Errorf("Error Query fuzzy search Movie: %w", err)
+	}
+	defer rows.Close()
+
+	var result []model.MovieSearchItem
+	totalCount := 0
+
+	for rows.Next() {
+		var (
+			id          uuid.UUID
+			title       string
+			overview    sql.NullString
+			releaseDate sql.NullString
+			voteAverage sql.NullFloat64
+			popularity  sql.NullFloat64
+			similarity  float64
+			score       float64
+			total       sql.NullInt64
+		)
+
+		if err := rows.Scan(&id, &title, &overview, &releaseDate, &voteAverage, &popularity, &similarity, &score, &total); err != nil {
+			return 0, []model.MovieSearchItem{}, fmt.Errorf("Error fuzzy search movie row scan: %w", err)
+		}
+
+		item := model.MovieSearchItem{
+			ID:         id,
+			Title:      title,
+			Score:      score,
+			Similarity: &similarity,
+		}
+
+		if overview.Valid {
+			item.Overview = &overview.String
+		}
+		if releaseDate.Valid {
+			item.ReleaseDate = &releaseDate.String
+		}
+		if voteAverage.Valid {
+			v := voteAverage.Float64
+			item.VoteAverage = &v
+		}
+		if popularity.Valid {
+			p := popularity.Float64
+			item.Popularity = &p
+		}
+
+		result = append(result, item)
+
+		if total.Valid {
+			totalCount = int(total.Int64)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, []model.MovieSearchItem{}, fmt.Errorf("Error fuzzy search movie rows: %w", err)
+	}
+
+	return totalCount, result, nil
+}
@@ -14,7 +14,7 @@ func (r *Movie_repo) GetMovieBasebyId(ctx context.Context, id, lang string) (mod
 	           m.id, COALESCE(mt.title,m.title) AS title, COALESCE(mt.overview,m.overview) AS overview,
 			   to_char(m.release_date, 'YYYY-MM-DD') AS release_data,
 			   ms.vote_average, ms.vote_count,
-			   m.poster_path, m.backdrop_path,m.budget,m.revenue,m.homepage
+			   m.poster_path, m.backdrop_path,m.budget,m.revenue,m.homepage,m.updated_at
 			   FROM movies m
 			   LEFT JOIN movie_translations mt ON mt.movie_id = m.id AND mt.language = $2
 			   LEFT JOIN movie_stats ms ON ms.movie_id = m.id
@@ -30,7 +30,8 @@ func (r *Movie_repo) GetMovieBasebyId(ctx context.Context, id, lang string) (mod
 		&res.BackdropPath,
 		&res.Budget,
 		&res.Revenue,
-		&res.Homepage)
+		&res.Homepage,
+		&res.UpdatedAt)
 	if err != nil {
 		return model.MovieResponse{}, fmt.Errorf("Query movie base: %w", err)
 	}
@@ -4,16 +4,56 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/gofrs/uuid/v5"
 	"github.com/h-raju-arch/movie_app_backend/internal/model"
 )
 
 // MovieRepository defines the interface for movie data access operations.
 // This interface allows for easy mocking in unit tests.
+//
+// The search and discover methods return up to pageSize+1 rows; the extra
+// row only signals that a next page exists and is never shown to clients.
 type MovieRepository interface {
 	GetMovieBasebyId(ctx context.Context, id, lang string) (model.MovieResponse, error)
-	FetchGenres(ctx context.Context, id string) ([]string, error)
-	FetchCompanies(ctx context.Context, id string) ([]string, error)
-	FetchCredits(ctx context.Context, id string) ([]model.Credits_Response, error)
-	SearchMovie(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int) (int, []model.MovieSearchItem, error)
+	FetchGenres(ctx context.Context, id, lang string) ([]model.Genre, error)
+	FetchCompanies(ctx context.Context, id string) ([]model.ProductionCompany, error)
+	FetchCredits(ctx context.Context, id string) ([]model.MovieCredit, error)
+	FetchImages(ctx context.Context, id string, filter model.LanguageFilter) ([]model.Image, error)
+	FetchVideos(ctx context.Context, id string, filter model.LanguageFilter) ([]model.Video, error)
+	FetchSpokenLanguages(ctx context.Context, id string) ([]model.Language, error)
+	FetchTranslations(ctx context.Context, id string) ([]model.MovieTranslation, error)
+	FetchAccountStates(ctx context.Context, id string, userID uuid.UUID) (model.AccountStates, error)
+
+	// FetchReviews returns one page of the movie's approved reviews, plus
+	// viewer's own review whatever its status (uuid.Nil for anonymous
+	// viewers), newest first, and the total number of them.
+	FetchReviews(ctx context.Context, id string, viewer uuid.UUID, page, pageSize int) ([]model.Review, int, error)
+	SearchMovie(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error)
+	FuzzySearchMovie(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error)
 	DiscoverMovies(ctx context.Context, params model.DiscoverMoviesParams) ([]model.DiscoverItem, int, error)
+
+	// FetchSimilar returns one page of the movies sharing genres, companies,
+	// top-billed cast, the director or the composer with the movie, best
+	// scored first (see SimilarGenreWeight and friends) with popularity
+	// breaking ties, and the total number of them.
+	FetchSimilar(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) ([]model.DiscoverItem, int, error)
+}
+
+// MovieWriter is the write side of the movie store. Each method writes
+// movies, movie_stats and the genre, company and spoken language links in a
+// single transaction.
+//
+// Updates and deletes of an unknown id return an error wrapping
+// sql.ErrNoRows; genre ids, company ids or languages that don't exist
+// return a *model.ValidationError.
+type MovieWriter interface {
+	CreateMovie(ctx context.Context, id uuid.UUID, w model.MovieWrite) error
+	ReplaceMovie(ctx context.Context, id string, w model.MovieWrite) error
+	PatchMovie(ctx context.Context, id string, w model.MovieWrite) error
+	DeleteMovie(ctx context.Context, id string) error
+
+	// PutTranslation inserts or replaces the movie's translation into lang
+	// and reports whether it was inserted.
+	PutTranslation(ctx context.Context, id, lang string, t model.TranslationWrite) (bool, error)
+	DeleteTranslation(ctx context.Context, id, lang string) error
 }
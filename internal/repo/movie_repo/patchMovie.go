@@ -0,0 +1,50 @@
+package movierepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// PatchMovie updates the columns set in w and keeps the others, as PATCH
+// does.
+func (r Movie_repo) PatchMovie(ctx context.Context, id string, w model.MovieWrite) error {
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		query := `UPDATE movies SET
+		            title = COALESCE($2, title),
+		            original_title = COALESCE($3, original_title),
+		            original_language = COALESCE($4, original_language),
+		            tag_line = COALESCE($5, tag_line),
+		            overview = COALESCE($6, overview),
+		            release_date = COALESCE($7::date, release_date),
+		            runtime = COALESCE($8, runtime),
+		            adult = COALESCE($9, adult),
+		            homepage = COALESCE($10, homepage),
+		            poster_path = COALESCE($11, poster_path),
+		            backdrop_path = COALESCE($12, backdrop_path),
+		            budget = COALESCE($13, budget),
+		            revenue = COALESCE($14, revenue),
+		            updated_at = now()
+		          WHERE id = $1`
+
+		res, err := tx.ExecContext(ctx, query, id, w.Title, w.OriginalTitle, w.OriginalLanguage, w.TagLine, w.Overview,
+			w.ReleaseDate, w.Runtime, w.Adult, w.Homepage, w.PosterPath, w.BackdropPath, w.Budget, w.Revenue)
+		if err := updated(res, err); err != nil {
+			return fmt.Errorf("Error Query PatchMovie: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO movie_stats (movie_id, popularity, base_popularity)
+		          VALUES ($1, COALESCE($2, 0), COALESCE($2, 0))
+		          ON CONFLICT (movie_id) DO UPDATE SET
+		            popularity = COALESCE($2, movie_stats.popularity),
+		            base_popularity = COALESCE($2, movie_stats.base_popularity)`,
+			id, w.Popularity)
+		if err != nil {
+			return fmt.Errorf("Error Query PatchMovie stats: %w", err)
+		}
+
+		return writeMovieLinks(ctx, tx, id, w, false)
+	})
+}
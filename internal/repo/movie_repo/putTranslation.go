@@ -0,0 +1,37 @@
+package movierepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/lib/pq"
+)
+
+func (r Movie_repo) PutTranslation(ctx context.Context, id, lang string, t model.TranslationWrite) (bool, error) {
+	var inserted bool
+	err := r.inTx(ctx, func(tx *sql.Tx) error {
+		// xmax is only set on rows the ON CONFLICT branch updated
+		query := `INSERT INTO movie_translations (movie_id, language, title, overview)
+		          VALUES ($1, $2, $3, $4)
+		          ON CONFLICT (movie_id, language) DO UPDATE SET title = EXCLUDED.title, overview = EXCLUDED.overview
+		          RETURNING xmax = 0`
+
+		err := tx.QueryRowContext(ctx, query, id, lang, t.Title, t.Overview).Scan(&inserted)
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" { // no such movie
+			return fmt.Errorf("Error Query PutTranslation: %w", sql.ErrNoRows)
+		}
+		if err != nil {
+			return fmt.Errorf("Error Query PutTranslation: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE movies SET updated_at = now() WHERE id = $1`, id); err != nil {
+			return fmt.Errorf("Error Query PutTranslation touch movie: %w", err)
+		}
+		return nil
+	})
+	return inserted, err
+}
@@ -0,0 +1,54 @@
+package movierepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// ReplaceMovie overwrites every column of a movie, as PUT does.
+func (r Movie_repo) ReplaceMovie(ctx context.Context, id string, w model.MovieWrite) error {
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		query := `UPDATE movies SET
+		            title = $2, original_title = $3, original_language = $4, tag_line = $5, overview = $6,
+		            release_date = $7::date, runtime = $8, adult = COALESCE($9, FALSE), homepage = $10,
+		            poster_path = $11, backdrop_path = $12, budget = COALESCE($13, 0), revenue = COALESCE($14, 0),
+		            updated_at = now()
+		          WHERE id = $1`
+
+		res, err := tx.ExecContext(ctx, query, id, w.Title, w.OriginalTitle, w.OriginalLanguage, w.TagLine, w.Overview,
+			w.ReleaseDate, w.Runtime, w.Adult, w.Homepage, w.PosterPath, w.BackdropPath, w.Budget, w.Revenue)
+		if err := updated(res, err); err != nil {
+			return fmt.Errorf("Error Query ReplaceMovie: %w", err)
+		}
+
+		// the vote columns are the ratings' and are left as they are
+		_, err = tx.ExecContext(ctx, `INSERT INTO movie_stats (movie_id, popularity, base_popularity)
+		          VALUES ($1, COALESCE($2, 0), COALESCE($2, 0))
+		          ON CONFLICT (movie_id) DO UPDATE SET
+		            popularity = EXCLUDED.popularity, base_popularity = EXCLUDED.base_popularity`,
+			id, w.Popularity)
+		if err != nil {
+			return fmt.Errorf("Error Query ReplaceMovie stats: %w", err)
+		}
+
+		return writeMovieLinks(ctx, tx, id, w, true)
+	})
+}
+
+// updated turns an UPDATE or DELETE that matched no row into sql.ErrNoRows.
+func updated(res sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -7,9 +7,13 @@ import (
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/h-raju-arch/movie_app_backend/internal/pagination"
 )
 
-func (r Movie_repo) SearchMovie(ctx context.Context, queryStr string, adult bool, lang string, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int) (int, []model.MovieSearchItem, error) {
+// SearchMovie returns up to pageSize+1 rows: the extra row only tells the
+// caller that another page exists. With a cursor the page starts right after
+// the cursor row and the total only counts the rows after it.
+func (r Movie_repo) SearchMovie(ctx context.Context, queryStr string, adult bool, lang string, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -20,6 +24,14 @@ func (r Movie_repo) SearchMovie(ctx context.Context, queryStr string, adult bool
 
 	offset := (page - 1) * pageSize
 
+	afterScore, afterID, err := searchAfter(cursor, pagination.SortSearch)
+	if err != nil {
+		return 0, []model.MovieSearchItem{}, fmt.Errorf("Search movie cursor: %w", err)
+	}
+	if afterID != nil {
+		offset = 0
+	}
+
 	var year interface{}
 
 	if primaryYear.Valid {
@@ -35,34 +47,57 @@ func (r Movie_repo) SearchMovie(ctx context.Context, queryStr string, adult bool
 		regionParam = nil
 	}
 
+	// Full-text match over the base movie (English title/tag line/overview,
+	// unstemmed original title) and its translation in the requested
+	// language, stemmed with that language's config. The "simple" branch keeps
+	// exact words matching even when the stemmer drops or rewrites them.
+	// score blends relevance (ts_rank, normalised to 0..1) with popularity so
+	// a title hit outranks an overview mention on a more popular movie.
 	query := `
-SELECT
-  m.id,
-  COALESCE(mt.title, m.title) AS title,
-  COALESCE(mt.overview, m.overview) AS overview,
-  to_char(m.release_date, 'YYYY-MM-DD') AS release_date,
-  ms.vote_average,
-  ms.popularity,
-  COUNT(*) OVER() AS total_count
-FROM movies m
-LEFT JOIN movie_translations mt ON mt.movie_id = m.id AND mt.language = $2
-LEFT JOIN movie_stats ms ON ms.movie_id = m.id
-WHERE (
-    COALESCE(mt.title, m.title) ILIKE '%' || $1 || '%'
-    OR COALESCE(mt.overview, m.overview) ILIKE '%' || $1 || '%'
-)
-AND ($3 OR m.adult = false)
-AND ($4::int IS NULL OR EXTRACT(YEAR FROM m.release_date)::int = $4::int)
-AND (
-  $5::text IS NULL OR EXISTS (
-    SELECT 1
-    FROM movie_companies mc
-    JOIN companies c ON c.id = mc.company_id
-    WHERE mc.movie_id = m.id
-      AND c.origin_country = $5::text
+WITH q AS (
+  SELECT
+    websearch_to_tsquery('english', $1) || websearch_to_tsquery('simple', $1) AS base,
+    websearch_to_tsquery(movie_ts_config($2), $1) || websearch_to_tsquery('simple', $1) AS lang
+),
+ranked AS (
+  SELECT
+    m.id,
+    COALESCE(mt.title, m.title) AS title,
+    COALESCE(mt.overview, m.overview) AS overview,
+    to_char(m.release_date, 'YYYY-MM-DD') AS release_date,
+    ms.vote_average,
+    ms.popularity,
+    (0.8 * GREATEST(
+            ts_rank(m.search_vector, q.base, 32),
+            COALESCE(ts_rank(mt.search_vector, q.lang, 32), 0)
+          )
+    + 0.2 * COALESCE(ms.popularity, 0) / (COALESCE(ms.popularity, 0) + 100))::double precision AS score
+  FROM movies m
+  CROSS JOIN q
+  LEFT JOIN movie_translations mt ON mt.movie_id = m.id AND mt.language = $2
+  LEFT JOIN movie_stats ms ON ms.movie_id = m.id
+  WHERE m.id IN (
+      SELECT mv.id FROM movies mv, q WHERE mv.search_vector @@ q.base
+      UNION
+      SELECT t.movie_id FROM movie_translations t, q WHERE t.language = $2 AND t.search_vector @@ q.lang
+  )
+  AND ($3 OR m.adult = false)
+  AND ($4::int IS NULL OR EXTRACT(YEAR FROM m.release_date)::int = $4::int)
+  AND (
+    $5::text IS NULL OR EXISTS (
+      SELECT 1
+      FROM movie_companies mc
+      JOIN companies c ON c.id = mc.company_id
+      WHERE mc.movie_id = m.id
+        AND c.origin_country = $5::text
+    )
   )
 )
-ORDER BY ms.popularity DESC NULLS LAST, m.created_at DESC
+SELECT id, title, overview, release_date, vote_average, popularity, score,
+  COUNT(*) OVER() AS total_count
+FROM ranked
+WHERE $8::uuid IS NULL OR (score, id) < ($9::double precision, $8::uuid)
+ORDER BY score DESC, id DESC
 LIMIT $6 OFFSET $7;
 `
 
@@ -72,8 +107,10 @@ LIMIT $6 OFFSET $7;
 		adult,       // $3
 		year,        // $4 (sql.NullString)
 		regionParam, // $5 (sql.NullString)
-		pageSize,    // $6
+		pageSize+1,  // $6 (one extra row to detect a next page)
 		offset,      // $7
+		afterID,     // $8
+		afterScore,  // $9
 	)
 	if err != nil {
 		return 0, []model.MovieSearchItem{}, fmt.Errorf("Error Query search Movie: %w", err)
@@ -91,10 +128,11 @@ LIMIT $6 OFFSET $7;
 			releaseDate sql.NullString
 			voteAverage sql.NullFloat64
 			popularity  sql.NullFloat64
+			score       float64
 			total       sql.NullInt64
 		)
 
-		if err := rows.Scan(&id, &title, &overview, &releaseDate, &voteAverage, &popularity, &total); err != nil {
+		if err := rows.Scan(&id, &title, &overview, &releaseDate, &voteAverage, &popularity, &score, &total); err != nil {
 
 			return 0, []model.MovieSearchItem{}, fmt.Errorf("Error Search movie row scan: %w", err)
 		}
@@ -102,6 +140,7 @@ LIMIT $6 OFFSET $7;
 		item := model.MovieSearchItem{
 			ID:    id,
 			Title: title,
+			Score: score,
 		}
 
 		if overview.Valid {
@@ -133,3 +172,19 @@ LIMIT $6 OFFSET $7;
 
 	return totalCount, result, nil
 }
+
+// searchAfter decodes a search cursor into its (score, id) keyset params.
+// Both are nil when there is no cursor.
+func searchAfter(cursor, sort string) (score, id interface{}, err error) {
+	if cursor == "" {
+		return nil, nil, nil
+	}
+	c, err := pagination.DecodeFor(cursor, sort)
+	if err != nil {
+		return nil, nil, err
+	}
+	if c.Value == nil {
+		return nil, nil, pagination.ErrInvalidCursor
+	}
+	return *c.Value, c.ID, nil
+}
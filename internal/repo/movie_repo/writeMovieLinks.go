@@ -0,0 +1,68 @@
+package movierepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/lib/pq"
+)
+
+// writeMovieLinks replaces the genre, company and spoken language links of a
+// movie. With replace unset a nil list leaves that table alone (PATCH),
+// otherwise it removes every link.
+func writeMovieLinks(ctx context.Context, tx *sql.Tx, id string, w model.MovieWrite, replace bool) error {
+	links := []struct {
+		ids            []string
+		set            bool
+		table, column  string
+		typ            string // column type the text values are cast to
+		field, message string
+	}{
+		{uuidStrings(w.GenreIDs), w.GenreIDs != nil, "movie_genres", "genre_id", "uuid", "genre_ids", "references an unknown genre"},
+		{uuidStrings(w.CompanyIDs), w.CompanyIDs != nil, "movie_companies", "company_id", "uuid", "company_ids", "references an unknown company"},
+		{deref(w.SpokenLanguages), w.SpokenLanguages != nil, "movie_spoken_languages", "iso_639_1", "text", "spoken_languages", "references an unknown language"},
+	}
+
+	for _, l := range links {
+		if !l.set && !replace {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+l.table+` WHERE movie_id = $1`, id); err != nil {
+			return fmt.Errorf("Error Query delete %s: %w", l.table, err)
+		}
+		if len(l.ids) == 0 {
+			continue
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO `+l.table+` (movie_id, `+l.column+`) SELECT $1::uuid, unnest($2::text[])::`+l.typ, id, pq.Array(l.ids))
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" { // foreign_key_violation
+			return &model.ValidationError{Errors: []model.FieldError{{Field: l.field, Message: l.message}}}
+		}
+		if err != nil {
+			return fmt.Errorf("Error Query insert %s: %w", l.table, err)
+		}
+	}
+	return nil
+}
+
+func uuidStrings(ids *[]uuid.UUID) []string {
+	if ids == nil {
+		return nil
+	}
+	res := make([]string, len(*ids))
+	for i, id := range *ids {
+		res[i] = id.String()
+	}
+	return res
+}
+
+func deref[T any](s *[]T) []T {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
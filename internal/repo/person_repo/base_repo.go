@@ -0,0 +1,11 @@
+package personrepo
+
+import "database/sql"
+
+type Person_repo struct {
+	db *sql.DB
+}
+
+func New_Person_Repo(db *sql.DB) *Person_repo {
+	return &Person_repo{db: db}
+}
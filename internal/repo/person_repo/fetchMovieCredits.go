@@ -0,0 +1,43 @@
+package personrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// FetchMovieCredits returns every cast and crew credit of a person, with the
+// movie title in lang, ordered by release date (undated movies last).
+func (r *Person_repo) FetchMovieCredits(ctx context.Context, id, lang string) ([]model.PersonMovieCredit, error) {
+	query := `SELECT c.id, m.id, COALESCE(mt.title, m.title) AS title,
+	            to_char(m.release_date, 'YYYY-MM-DD') AS release_date, m.poster_path,
+	            c.credit_type, c.character_name, c.cast_order, c.department, c.job
+	          FROM credits c
+	          JOIN movies m ON m.id = c.movie_id
+	          LEFT JOIN movie_translations mt ON mt.movie_id = m.id AND mt.language = $2
+	          WHERE c.person_id = $1
+	          ORDER BY m.release_date ASC NULLS LAST, title, c.id`
+
+	rows, err := r.db.QueryContext(ctx, query, id, lang)
+	if err != nil {
+		return []model.PersonMovieCredit{}, fmt.Errorf("Query FetchMovieCredits : %w", err)
+	}
+	defer rows.Close()
+
+	resp := []model.PersonMovieCredit{}
+	for rows.Next() {
+		var temp model.PersonMovieCredit
+		err := rows.Scan(&temp.CreditID, &temp.MovieID, &temp.Title, &temp.ReleaseDate, &temp.PosterPath,
+			&temp.CreditType, &temp.Character, &temp.CastOrder, &temp.Department, &temp.Job)
+		if err != nil {
+			return []model.PersonMovieCredit{}, fmt.Errorf("Error movie credit rows scan: %w", err)
+		}
+		resp = append(resp, temp)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []model.PersonMovieCredit{}, fmt.Errorf("Error Fetch Movie Credits row: %w", err)
+	}
+	return resp, nil
+}
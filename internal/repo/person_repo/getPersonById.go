@@ -0,0 +1,22 @@
+package personrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r *Person_repo) GetPersonById(ctx context.Context, id string) (model.PersonResponse, error) {
+	var res model.PersonResponse
+
+	query := `SELECT p.id, p.name, p.known_for, p.profile_path
+	          FROM people p
+			  WHERE p.id = $1;`
+
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&res.ID, &res.Name, &res.KnownFor, &res.ProfilePath)
+	if err != nil {
+		return model.PersonResponse{}, fmt.Errorf("Query person: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,14 @@
+package personrepo
+
+import (
+	"context"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// PersonRepository defines the data access operations for people and their
+// credits. This interface allows for easy mocking in unit tests.
+type PersonRepository interface {
+	GetPersonById(ctx context.Context, id string) (model.PersonResponse, error)
+	FetchMovieCredits(ctx context.Context, id, lang string) ([]model.PersonMovieCredit, error)
+}
@@ -0,0 +1,32 @@
+package ratingrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type Rating_repo struct {
+	db *sql.DB
+}
+
+func New_Rating_Repo(db *sql.DB) *Rating_repo {
+	return &Rating_repo{db: db}
+}
+
+// inTx runs fn in a transaction, committing only if it returns nil.
+func (r Rating_repo) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Error begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("Error commit tx: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package ratingrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/lib/pq"
+)
+
+func (r Rating_repo) FetchRatedMovies(ctx context.Context, userID uuid.UUID, lang string, page, pageSize int) ([]model.RatedMovie, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	query := `
+    SELECT
+      m.id,
+      COALESCE(mt.title,m.title) AS title,
+      COALESCE(mt.overview,m.overview) AS overview,
+      to_char(m.release_date, 'YYYY-MM-DD') AS release_date,
+      ms.vote_average, ms.vote_count,
+      m.poster_path, m.backdrop_path, ms.popularity,
+      (SELECT COALESCE(array_agg(mg.genre_id::text), ARRAY[]::text[])
+         FROM movie_genres mg
+         WHERE mg.movie_id = m.id
+      ) AS genre_ids,
+      r.value, r.updated_at,
+      COUNT(*) OVER() AS total_count
+    FROM ratings r
+    JOIN movies m ON m.id = r.movie_id
+    LEFT JOIN movie_translations mt ON mt.movie_id = m.id AND mt.language = $2
+    LEFT JOIN movie_stats ms ON ms.movie_id = m.id
+    WHERE r.user_id = $1
+    ORDER BY r.updated_at DESC, m.id
+    LIMIT $3 OFFSET $4`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, lang, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error Querying rated movies: %w", err)
+	}
+	defer rows.Close()
+
+	items := []model.RatedMovie{}
+	totalCount := 0
+
+	for rows.Next() {
+		var (
+			it          model.RatedMovie
+			overview    sql.NullString
+			releaseDate sql.NullString
+			voteAvg     sql.NullFloat64
+			voteCount   sql.NullInt64
+			poster      sql.NullString
+			backdrop    sql.NullString
+			popularity  sql.NullFloat64
+			genreIDs    pq.StringArray
+			total       int
+		)
+
+		if err := rows.Scan(&it.ID, &it.Title, &overview, &releaseDate, &voteAvg, &voteCount, &poster, &backdrop, &popularity, &genreIDs,
+			&it.Rating, &it.RatedAt, &total); err != nil {
+			return nil, 0, fmt.Errorf("Error on rows rated movies: %w", err)
+		}
+
+		if overview.Valid {
+			it.Overview = &overview.String
+		}
+		if releaseDate.Valid {
+			it.ReleaseDate = &releaseDate.String
+		}
+		if voteAvg.Valid {
+			it.VoteAverage = &voteAvg.Float64
+		}
+		if voteCount.Valid {
+			vc := int(voteCount.Int64)
+			it.VoteCount = &vc
+		}
+		if poster.Valid {
+			it.PosterPath = &poster.String
+		}
+		if backdrop.Valid {
+			it.BackdropPath = &backdrop.String
+		}
+		if popularity.Valid {
+			it.Popularity = &popularity.Float64
+		}
+		it.GenreIDs = []string(genreIDs)
+
+		items = append(items, it)
+		totalCount = total
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return items, totalCount, nil
+}
@@ -0,0 +1,29 @@
+package ratingrepo
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// RatingRepository stores users' movie ratings. This interface allows for
+// easy mocking in unit tests.
+//
+// Every change is folded into the movie's movie_stats vote_average and
+// vote_count, and bumps its updated_at, in the same transaction, with the
+// stats row locked so concurrent ratings of a movie apply one after the
+// other. Nothing else writes those columns once the movie exists: the votes
+// it was seeded or first imported with stay the base ratings are folded
+// into. Unknown movies, and deleting a rating that doesn't exist, return an
+// error wrapping sql.ErrNoRows.
+type RatingRepository interface {
+	// RateMovie inserts or replaces userID's rating of the movie and
+	// reports whether it was inserted.
+	RateMovie(ctx context.Context, userID uuid.UUID, movieID string, value float64) (model.MovieRating, bool, error)
+	DeleteRating(ctx context.Context, userID uuid.UUID, movieID string) (model.MovieRating, error)
+
+	// FetchRatedMovies returns one page of the movies userID rated, most
+	// recently rated first, and the total number of them.
+	FetchRatedMovies(ctx context.Context, userID uuid.UUID, lang string, page, pageSize int) ([]model.RatedMovie, int, error)
+}
@@ -0,0 +1,102 @@
+package ratingrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r Rating_repo) RateMovie(ctx context.Context, userID uuid.UUID, movieID string, value float64) (model.MovieRating, bool, error) {
+	var (
+		res      model.MovieRating
+		inserted bool
+	)
+	err := r.inTx(ctx, func(tx *sql.Tx) error {
+		if err := lockStats(ctx, tx, movieID); err != nil {
+			return err
+		}
+
+		var old float64
+		err := tx.QueryRowContext(ctx, `SELECT value FROM ratings WHERE user_id = $1 AND movie_id = $2`, userID, movieID).Scan(&old)
+		inserted = errors.Is(err, sql.ErrNoRows)
+		if err != nil && !inserted {
+			return fmt.Errorf("Error Query RateMovie old rating: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO ratings (user_id, movie_id, value) VALUES ($1, $2, $3)
+		          ON CONFLICT (user_id, movie_id) DO UPDATE SET value = EXCLUDED.value, updated_at = now()`,
+			userID, movieID, value)
+		if err != nil {
+			return fmt.Errorf("Error Query RateMovie: %w", err)
+		}
+
+		countDelta := 0
+		if inserted {
+			countDelta = 1
+		}
+		res, err = applyVote(ctx, tx, movieID, value-old, countDelta)
+		res.Rating = &value
+		return err
+	})
+	return res, inserted, err
+}
+
+func (r Rating_repo) DeleteRating(ctx context.Context, userID uuid.UUID, movieID string) (model.MovieRating, error) {
+	var res model.MovieRating
+	err := r.inTx(ctx, func(tx *sql.Tx) error {
+		if err := lockStats(ctx, tx, movieID); err != nil {
+			return err
+		}
+
+		var old float64
+		err := tx.QueryRowContext(ctx, `DELETE FROM ratings WHERE user_id = $1 AND movie_id = $2 RETURNING value`, userID, movieID).Scan(&old)
+		if err != nil {
+			return fmt.Errorf("Error Query DeleteRating: %w", err)
+		}
+
+		res, err = applyVote(ctx, tx, movieID, -old, -1)
+		return err
+	})
+	return res, err
+}
+
+// lockStats locks the movie's stats row until the transaction ends. Every
+// movie has one, so a missing row means the movie doesn't exist.
+func lockStats(ctx context.Context, tx *sql.Tx, movieID string) error {
+	var id uuid.UUID
+	err := tx.QueryRowContext(ctx, `SELECT movie_id FROM movie_stats WHERE movie_id = $1 FOR UPDATE`, movieID).Scan(&id)
+	if err != nil {
+		return fmt.Errorf("Error Query lock movie_stats: %w", err)
+	}
+	return nil
+}
+
+// applyVote adds sumDelta to the movie's sum of votes (vote_average *
+// vote_count) and countDelta to its vote_count, and returns the result.
+// The movie's updated_at is bumped too, so incremental exports pick up the
+// new vote_average and vote_count.
+func applyVote(ctx context.Context, tx *sql.Tx, movieID string, sumDelta float64, countDelta int) (model.MovieRating, error) {
+	res := model.MovieRating{}
+	query := `UPDATE movie_stats SET
+	            vote_average = CASE WHEN COALESCE(vote_count, 0) + $3 > 0
+	              THEN (COALESCE(vote_average, 0) * COALESCE(vote_count, 0) + $2) / (COALESCE(vote_count, 0) + $3)
+	              ELSE 0 END,
+	            vote_count = GREATEST(COALESCE(vote_count, 0) + $3, 0),
+	            scores_updated_at = now()
+	          WHERE movie_id = $1
+	          RETURNING movie_id, vote_average, vote_count`
+
+	err := tx.QueryRowContext(ctx, query, movieID, sumDelta, countDelta).Scan(&res.MovieID, &res.VoteAverage, &res.VoteCount)
+	if err != nil {
+		return res, fmt.Errorf("Error Query update movie_stats votes: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE movies SET updated_at = now() WHERE id = $1`, movieID); err != nil {
+		return res, fmt.Errorf("Error Query update movie updated_at: %w", err)
+	}
+	return res, nil
+}
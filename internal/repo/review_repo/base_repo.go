@@ -0,0 +1,58 @@
+package reviewrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+type Review_repo struct {
+	db *sql.DB
+}
+
+func New_Review_Repo(db *sql.DB) *Review_repo {
+	return &Review_repo{db: db}
+}
+
+// inTx runs fn in a transaction, committing only if it returns nil.
+func (r Review_repo) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Error begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("Error commit tx: %w", err)
+	}
+	return nil
+}
+
+// touchMovie bumps the movie's updated_at: the reviews it shows changed.
+func touchMovie(ctx context.Context, tx *sql.Tx, movieID string) error {
+	if _, err := tx.ExecContext(ctx, `UPDATE movies SET updated_at = now() WHERE id = $1`, movieID); err != nil {
+		return fmt.Errorf("Error Query update movie updated_at: %w", err)
+	}
+	return nil
+}
+
+// reviewColumns are the columns scanReview reads, in order.
+const reviewColumns = `id, movie_id, author_id, rating, content, status, created_at, updated_at`
+
+// scanReview scans reviewColumns, followed by dest.
+func scanReview(row interface{ Scan(...any) error }, dest ...any) (model.Review, error) {
+	var (
+		rv     model.Review
+		rating sql.NullFloat64
+	)
+	err := row.Scan(append([]any{&rv.ID, &rv.MovieID, &rv.AuthorID, &rating, &rv.Content, &rv.Status, &rv.CreatedAt, &rv.UpdatedAt}, dest...)...)
+	if rating.Valid {
+		rv.Rating = &rating.Float64
+	}
+	return rv, err
+}
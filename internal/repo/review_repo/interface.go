@@ -0,0 +1,29 @@
+package reviewrepo
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// ReviewRepository stores users' reviews and their moderation. This
+// interface allows for easy mocking in unit tests. A movie's public reviews
+// are read through movierepo.MovieRepository.FetchReviews.
+type ReviewRepository interface {
+	// SaveReview inserts authorID's review of the movie under id, or
+	// replaces the content and rating of their existing one (keeping its
+	// id), and reports whether it was inserted. Either way the review is
+	// pending again; replacing an approved one bumps the movie's
+	// updated_at. Unknown movies return an error wrapping sql.ErrNoRows.
+	SaveReview(ctx context.Context, id uuid.UUID, movieID string, authorID uuid.UUID, w model.ReviewWrite) (model.Review, bool, error)
+
+	// FetchReviewsByStatus returns one page of the reviews with status,
+	// least recently written first, and the total number of them.
+	FetchReviewsByStatus(ctx context.Context, status string, page, pageSize int) ([]model.Review, int, error)
+
+	// SetReviewStatus moderates a review, bumping its movie's updated_at when
+	// the review is approved or stops being approved.
+	// Unknown ids return an error wrapping sql.ErrNoRows.
+	SetReviewStatus(ctx context.Context, id, status string) (model.Review, error)
+}
@@ -0,0 +1,74 @@
+package reviewrepo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r Review_repo) FetchReviewsByStatus(ctx context.Context, status string, page, pageSize int) ([]model.Review, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	query := `SELECT ` + reviewColumns + `, COUNT(*) OVER() AS total_count
+	          FROM reviews
+	          WHERE status = $1
+	          ORDER BY updated_at, id
+	          LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.QueryContext(ctx, query, status, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error Query FetchReviewsByStatus: %w", err)
+	}
+	defer rows.Close()
+
+	res := []model.Review{}
+	totalCount := 0
+	for rows.Next() {
+		rv, err := scanReview(rows, &totalCount)
+		if err != nil {
+			return nil, 0, fmt.Errorf("Error Fetch Reviews By Status row scan: %w", err)
+		}
+		res = append(res, rv)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("Error Fetch Reviews By Status row: %w", err)
+	}
+	return res, totalCount, nil
+}
+
+func (r Review_repo) SetReviewStatus(ctx context.Context, id, status string) (model.Review, error) {
+	query := `UPDATE reviews SET status = $2 WHERE id = $1 RETURNING ` + reviewColumns
+
+	var rv model.Review
+	err := r.inTx(ctx, func(tx *sql.Tx) error {
+		var old string
+		err := tx.QueryRowContext(ctx, `SELECT status FROM reviews WHERE id = $1 FOR UPDATE`, id).Scan(&old)
+		if err != nil {
+			return fmt.Errorf("Error Query SetReviewStatus old status: %w", err)
+		}
+
+		rv, err = scanReview(tx.QueryRowContext(ctx, query, id, status))
+		if err != nil {
+			return fmt.Errorf("Error Query SetReviewStatus: %w", err)
+		}
+
+		// only approved reviews show on the movie
+		if old == model.ReviewApproved || status == model.ReviewApproved {
+			return touchMovie(ctx, tx, rv.MovieID.String())
+		}
+		return nil
+	})
+	if err != nil {
+		return model.Review{}, err
+	}
+	return rv, nil
+}
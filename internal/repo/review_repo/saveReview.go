@@ -0,0 +1,54 @@
+package reviewrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/lib/pq"
+)
+
+func (r Review_repo) SaveReview(ctx context.Context, id uuid.UUID, movieID string, authorID uuid.UUID, w model.ReviewWrite) (model.Review, bool, error) {
+	// xmax is only set on rows the ON CONFLICT branch updated
+	query := `INSERT INTO reviews (id, movie_id, author_id, rating, content)
+	          VALUES ($1, $2, $3, $4, $5)
+	          ON CONFLICT (movie_id, author_id) DO UPDATE SET
+	            rating = EXCLUDED.rating, content = EXCLUDED.content,
+	            status = 'pending', updated_at = now()
+	          RETURNING ` + reviewColumns + `, xmax = 0`
+
+	var (
+		rv       model.Review
+		inserted bool
+	)
+	err := r.inTx(ctx, func(tx *sql.Tx) error {
+		var old string
+		err := tx.QueryRowContext(ctx, `SELECT status FROM reviews WHERE movie_id = $1 AND author_id = $2 FOR UPDATE`,
+			movieID, authorID).Scan(&old)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("Error Query SaveReview old status: %w", err)
+		}
+
+		rv, err = scanReview(tx.QueryRowContext(ctx, query, id, movieID, authorID, w.Rating, w.Content), &inserted)
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" { // no such movie
+			return fmt.Errorf("Error Query SaveReview: %w", sql.ErrNoRows)
+		}
+		if err != nil {
+			return fmt.Errorf("Error Query SaveReview: %w", err)
+		}
+
+		// an approved review going back to moderation disappears from the movie
+		if old == model.ReviewApproved {
+			return touchMovie(ctx, tx, movieID)
+		}
+		return nil
+	})
+	if err != nil {
+		return model.Review{}, false, err
+	}
+	return rv, inserted, nil
+}
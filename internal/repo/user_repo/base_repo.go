@@ -0,0 +1,11 @@
+package userrepo
+
+import "database/sql"
+
+type User_repo struct {
+	db *sql.DB
+}
+
+func New_User_Repo(db *sql.DB) *User_repo {
+	return &User_repo{db: db}
+}
@@ -0,0 +1,25 @@
+package userrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/lib/pq"
+)
+
+func (r User_repo) CreateUser(ctx context.Context, u model.User) error {
+	query := `INSERT INTO users (id, email, password_hash, created_at, updated_at)
+	          VALUES ($1, $2, $3, $4, $4)`
+
+	_, err := r.db.ExecContext(ctx, query, u.ID, u.Email, u.PasswordHash, u.CreatedAt)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" { // unique_violation
+		return ErrEmailTaken
+	}
+	if err != nil {
+		return fmt.Errorf("Error Query CreateUser: %w", err)
+	}
+	return nil
+}
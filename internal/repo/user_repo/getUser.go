@@ -0,0 +1,31 @@
+package userrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func (r User_repo) GetUserByEmail(ctx context.Context, email string) (model.User, error) {
+	var u model.User
+	query := `SELECT id, email, password_hash, created_at FROM users WHERE email = $1`
+
+	err := r.db.QueryRowContext(ctx, query, email).Scan(&u.ID, &u.Email, &u.PasswordHash, &u.CreatedAt)
+	if err != nil {
+		return model.User{}, fmt.Errorf("Query GetUserByEmail: %w", err)
+	}
+	return u, nil
+}
+
+func (r User_repo) GetUserById(ctx context.Context, id uuid.UUID) (model.User, error) {
+	var u model.User
+	query := `SELECT id, email, password_hash, created_at FROM users WHERE id = $1`
+
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&u.ID, &u.Email, &u.PasswordHash, &u.CreatedAt)
+	if err != nil {
+		return model.User{}, fmt.Errorf("Query GetUserById: %w", err)
+	}
+	return u, nil
+}
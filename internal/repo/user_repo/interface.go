@@ -0,0 +1,24 @@
+package userrepo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// ErrEmailTaken is returned by CreateUser when the email is already
+// registered.
+var ErrEmailTaken = errors.New("email already registered")
+
+// UserRepository stores user accounts. This interface allows for easy
+// mocking in unit tests.
+//
+// Emails are compared as given; callers normalize them. Unknown users
+// return an error wrapping sql.ErrNoRows.
+type UserRepository interface {
+	CreateUser(ctx context.Context, u model.User) error
+	GetUserByEmail(ctx context.Context, email string) (model.User, error)
+	GetUserById(ctx context.Context, id uuid.UUID) (model.User, error)
+}
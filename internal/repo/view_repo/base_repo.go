@@ -0,0 +1,13 @@
+package viewrepo
+
+import (
+	"database/sql"
+)
+
+type View_repo struct {
+	db *sql.DB
+}
+
+func New_View_Repo(db *sql.DB) *View_repo {
+	return &View_repo{db: db}
+}
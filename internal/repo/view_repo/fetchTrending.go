@@ -0,0 +1,45 @@
+package viewrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	movierepo "github.com/h-raju-arch/movie_app_backend/internal/repo/movie_repo"
+)
+
+func (r View_repo) FetchTrending(ctx context.Context, w Window, lang string, includeAdult bool, page, pageSize int) ([]model.DiscoverItem, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	offset := (page - 1) * pageSize
+
+	query := `
+    WITH scores AS (
+      SELECT v.movie_id, SUM(v.views * power(0.5, EXTRACT(EPOCH FROM ($2::timestamptz - v.hour)) / $3::float8)) AS score
+      FROM movie_views v
+      WHERE v.hour >= $1
+      GROUP BY v.movie_id
+    )
+    SELECT` + movierepo.DiscoverItemColumns + `
+    FROM scores s
+    JOIN movies m ON m.id = s.movie_id
+    LEFT JOIN movie_translations mt ON mt.movie_id = m.id AND mt.language = $4
+    LEFT JOIN movie_stats ms ON ms.movie_id = m.id
+    WHERE $5 OR m.adult = false
+    ORDER BY s.score DESC, ms.popularity DESC NULLS LAST, m.id
+    LIMIT $6 OFFSET $7`
+
+	rows, err := r.db.QueryContext(ctx, query, w.Since, w.Now, w.HalfLife.Seconds(), lang, includeAdult, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error Query FetchTrending: %w", err)
+	}
+	items, totalCount, err := movierepo.ScanDiscoverItems(rows)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error Query FetchTrending: %w", err)
+	}
+	return items, totalCount, nil
+}
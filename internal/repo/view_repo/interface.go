@@ -0,0 +1,36 @@
+package viewrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// Window is the span trending scores are computed over: the views counted
+// in hours starting at or after Since, each weighted by
+// 0.5^(age / HalfLife) where age is how long before Now its hour started.
+type Window struct {
+	Since    time.Time
+	Now      time.Time
+	HalfLife time.Duration
+}
+
+// ViewRepository stores hourly movie view counts and the scores computed
+// from them. This interface allows for easy mocking in unit tests.
+type ViewRepository interface {
+	// RecordViews adds each count to its movie's hour. Counts for movies
+	// that don't exist (any more) are dropped.
+	RecordViews(ctx context.Context, views []model.MovieViews) error
+
+	// FetchTrending returns one page of the movies viewed in the window,
+	// highest score first with popularity breaking ties, and the total
+	// number of them.
+	FetchTrending(ctx context.Context, w Window, lang string, includeAdult bool, page, pageSize int) ([]model.DiscoverItem, int, error)
+
+	// RefreshPopularity sets the movie_stats popularity of every movie to
+	// its base_popularity plus its score in the window (0 without views),
+	// then deletes the counts older than the window. Only movies whose
+	// popularity changes are written; it returns how many were.
+	RefreshPopularity(ctx context.Context, w Window) (int, error)
+}
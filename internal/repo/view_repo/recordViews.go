@@ -0,0 +1,40 @@
+package viewrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/lib/pq"
+)
+
+func (r View_repo) RecordViews(ctx context.Context, views []model.MovieViews) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(views))
+	hours := make([]string, len(views))
+	counts := make([]int64, len(views))
+	for i, v := range views {
+		ids[i] = v.MovieID.String()
+		hours[i] = v.Hour.UTC().Format(time.RFC3339)
+		counts[i] = int64(v.Views)
+	}
+
+	// the join drops movies deleted since they were viewed, and GROUP BY
+	// keeps ON CONFLICT from seeing the same row twice
+	query := `
+    INSERT INTO movie_views (movie_id, hour, views)
+    SELECT v.movie_id, v.hour, SUM(v.views)
+    FROM unnest($1::uuid[], $2::timestamptz[], $3::int[]) AS v(movie_id, hour, views)
+    JOIN movies m ON m.id = v.movie_id
+    GROUP BY v.movie_id, v.hour
+    ON CONFLICT (movie_id, hour) DO UPDATE SET views = movie_views.views + EXCLUDED.views`
+
+	if _, err := r.db.ExecContext(ctx, query, pq.Array(ids), pq.Array(hours), pq.Array(counts)); err != nil {
+		return fmt.Errorf("Error Query RecordViews: %w", err)
+	}
+	return nil
+}
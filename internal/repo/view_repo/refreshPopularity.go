@@ -0,0 +1,48 @@
+package viewrepo
+
+import (
+	"context"
+	"fmt"
+)
+
+func (r View_repo) RefreshPopularity(ctx context.Context, w Window) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Error begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	// every movie's target is base plus its window score (0 without views,
+	// so movies whose views aged out go back to their base), but only the
+	// rows that target changes are written
+	res, err := tx.ExecContext(ctx, `
+    UPDATE movie_stats ms SET popularity = t.popularity, scores_updated_at = now()
+    FROM (
+      SELECT s.movie_id, s.base_popularity + COALESCE(v.score, 0) AS popularity
+      FROM movie_stats s
+      LEFT JOIN (
+        SELECT movie_id, SUM(views * power(0.5, EXTRACT(EPOCH FROM ($2::timestamptz - hour)) / $3::float8)) AS score
+        FROM movie_views
+        WHERE hour >= $1
+        GROUP BY movie_id
+      ) v ON v.movie_id = s.movie_id
+    ) t
+    WHERE t.movie_id = ms.movie_id AND t.popularity IS DISTINCT FROM ms.popularity`,
+		w.Since, w.Now, w.HalfLife.Seconds())
+	if err != nil {
+		return 0, fmt.Errorf("Error Query update movie_stats popularity: %w", err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("Error Query update movie_stats popularity: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM movie_views WHERE hour < $1`, w.Since); err != nil {
+		return 0, fmt.Errorf("Error Query prune movie_views: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("Error commit tx: %w", err)
+	}
+	return int(updated), nil
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	accountrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/account_repo"
+	movierepo "github.com/h-raju-arch/movie_app_backend/internal/repo/movie_repo"
+)
+
+type Account_Service interface {
+	SetWatchlist(ctx context.Context, userID uuid.UUID, movieID string, on bool) (model.AccountStates, error)
+	SetFavorite(ctx context.Context, userID uuid.UUID, movieID string, on bool) (model.AccountStates, error)
+	GetWatchlist(ctx context.Context, userID uuid.UUID, lang string, page, pageSize int) (model.DiscoverMoviesResponse, error)
+	GetFavorites(ctx context.Context, userID uuid.UUID, lang string, page, pageSize int) (model.DiscoverMoviesResponse, error)
+}
+
+type account_service struct {
+	repo   accountrepo.AccountRepository
+	movies movierepo.MovieRepository
+}
+
+func New_Account_Service(r accountrepo.AccountRepository, movies movierepo.MovieRepository) *account_service {
+	return &account_service{repo: r, movies: movies}
+}
+
+// SetWatchlist adds the movie to the user's watchlist, or removes it when on
+// is false, and returns the movie's account states afterwards.
+func (r account_service) SetWatchlist(ctx context.Context, userID uuid.UUID, movieID string, on bool) (model.AccountStates, error) {
+	return r.setListed(ctx, accountrepo.Watchlist, userID, movieID, on)
+}
+
+// SetFavorite is SetWatchlist for the user's favorites.
+func (r account_service) SetFavorite(ctx context.Context, userID uuid.UUID, movieID string, on bool) (model.AccountStates, error) {
+	return r.setListed(ctx, accountrepo.Favorites, userID, movieID, on)
+}
+
+func (r account_service) setListed(ctx context.Context, list accountrepo.List, userID uuid.UUID, movieID string, on bool) (model.AccountStates, error) {
+	if err := r.repo.SetListed(ctx, list, userID, movieID, on); err != nil {
+		return model.AccountStates{}, fmt.Errorf("service: SetListed: %w", err)
+	}
+
+	states, err := r.movies.FetchAccountStates(ctx, movieID, userID)
+	if err != nil {
+		return model.AccountStates{}, fmt.Errorf("service: FetchAccountStates: %w", err)
+	}
+	return states, nil
+}
+
+// GetWatchlist returns one page of the user's watchlist, most recently
+// added first.
+func (r account_service) GetWatchlist(ctx context.Context, userID uuid.UUID, lang string, page, pageSize int) (model.DiscoverMoviesResponse, error) {
+	return r.getListed(ctx, accountrepo.Watchlist, userID, lang, page, pageSize)
+}
+
+// GetFavorites is GetWatchlist for the user's favorites.
+func (r account_service) GetFavorites(ctx context.Context, userID uuid.UUID, lang string, page, pageSize int) (model.DiscoverMoviesResponse, error) {
+	return r.getListed(ctx, accountrepo.Favorites, userID, lang, page, pageSize)
+}
+
+func (r account_service) getListed(ctx context.Context, list accountrepo.List, userID uuid.UUID, lang string, page, pageSize int) (model.DiscoverMoviesResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	movies, total, err := r.repo.FetchListedMovies(ctx, list, userID, lang, page, pageSize)
+	if err != nil {
+		return model.DiscoverMoviesResponse{}, fmt.Errorf("service: FetchListedMovies: %w", err)
+	}
+
+	res := model.DiscoverMoviesResponse{
+		Page:         page,
+		PageSize:     pageSize,
+		TotalResults: total,
+		Results:      movies,
+	}
+	if total > 0 {
+		res.TotalPages = int(math.Ceil(float64(total) / float64(pageSize)))
+	}
+	return res, nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/auth"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	memoryrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/memory_repo"
+)
+
+func TestSetWatchlistAndFavorite(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	svc := New_Account_Service(mem, mem)
+	ctx := context.Background()
+	user := uuid.Must(uuid.NewV7())
+	movieID := ds.Movies[0].ID.String()
+
+	states, err := svc.SetWatchlist(ctx, user, movieID, true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !states.Watchlist || states.Favorite || states.Rated != nil || states.ID != ds.Movies[0].ID {
+		t.Errorf("expected only the watchlist set, got %+v", states)
+	}
+
+	// adding twice is a no-op
+	svc.SetWatchlist(ctx, user, movieID, true)
+	states, _ = svc.SetFavorite(ctx, user, movieID, true)
+	if !states.Watchlist || !states.Favorite {
+		t.Errorf("expected watchlist and favorite, got %+v", states)
+	}
+	if res, _ := svc.GetWatchlist(ctx, user, "en", 1, 20); res.TotalResults != 1 {
+		t.Errorf("expected the movie listed once, got %d", res.TotalResults)
+	}
+
+	states, _ = svc.SetWatchlist(ctx, user, movieID, false)
+	if states.Watchlist || !states.Favorite {
+		t.Errorf("expected only the favorite left, got %+v", states)
+	}
+	// removing twice is a no-op too
+	if _, err := svc.SetWatchlist(ctx, user, movieID, false); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	if _, err := svc.SetFavorite(ctx, user, uuid.Must(uuid.NewV7()).String(), true); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows for an unknown movie, got %v", err)
+	}
+}
+
+func TestGetWatchlist(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	svc := New_Account_Service(mem, mem)
+	ctx := context.Background()
+	user := uuid.Must(uuid.NewV7())
+
+	for i := range 3 {
+		svc.SetWatchlist(ctx, user, ds.Movies[i].ID.String(), true)
+		time.Sleep(time.Millisecond)
+	}
+	svc.SetFavorite(ctx, user, ds.Movies[4].ID.String(), true)
+	svc.SetWatchlist(ctx, uuid.Must(uuid.NewV7()), ds.Movies[5].ID.String(), true)
+
+	res, err := svc.GetWatchlist(ctx, user, "es", 1, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if res.TotalResults != 3 || res.TotalPages != 2 || len(res.Results) != 2 {
+		t.Fatalf("expected 3 movies over 2 pages, got %+v", res)
+	}
+	if res.Results[0].ID != ds.Movies[2].ID {
+		t.Errorf("expected the latest addition first, got %s", res.Results[0].Title)
+	}
+
+	favorites, _ := svc.GetFavorites(ctx, user, "en", 1, 20)
+	if favorites.TotalResults != 1 || favorites.Results[0].ID != ds.Movies[4].ID {
+		t.Errorf("expected one favorite, got %+v", favorites)
+	}
+}
+
+func TestGetMovieById_AccountStates(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	movies := New_Movie_Service(mem)
+	user := uuid.Must(uuid.NewV7())
+	movieID := ds.Movies[0].ID.String()
+
+	New_Account_Service(mem, mem).SetFavorite(context.Background(), user, movieID, true)
+	New_Rating_Service(mem).RateMovie(context.Background(), user, movieID, model.RatingWrite{Value: 7.5})
+
+	// anonymous requests get no account_states
+	res, err := movies.GetMovieById(context.Background(), movieID, "en", []string{"account_states"}, model.AppendOptions{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if res.AccountStates != nil {
+		t.Errorf("expected no account states without a user, got %+v", res.AccountStates)
+	}
+
+	ctx := auth.WithUserID(context.Background(), user)
+	res, _ = movies.GetMovieById(ctx, movieID, "en", []string{"account_states"}, model.AppendOptions{})
+	if res.AccountStates == nil || res.AccountStates.Watchlist || !res.AccountStates.Favorite ||
+		res.AccountStates.Rated == nil || *res.AccountStates.Rated != 7.5 {
+		t.Errorf("expected favorite and rated 7.5, got %+v", res.AccountStates)
+	}
+}
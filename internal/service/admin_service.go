@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	languagerepo "github.com/h-raju-arch/movie_app_backend/internal/repo/language_repo"
+	movierepo "github.com/h-raju-arch/movie_app_backend/internal/repo/movie_repo"
+)
+
+type Admin_Service interface {
+	CreateMovie(ctx context.Context, w model.MovieWrite) (model.MovieResponse, error)
+	ReplaceMovie(ctx context.Context, id string, w model.MovieWrite) (model.MovieResponse, error)
+	PatchMovie(ctx context.Context, id string, w model.MovieWrite) (model.MovieResponse, error)
+	DeleteMovie(ctx context.Context, id string) error
+	PutTranslation(ctx context.Context, id, lang string, t model.TranslationWrite) (model.MovieTranslation, bool, error)
+	DeleteTranslation(ctx context.Context, id, lang string) error
+}
+
+type admin_service struct {
+	writer    movierepo.MovieWriter
+	movies    Movie_Service
+	languages languagerepo.LanguageRepository
+}
+
+func New_Admin_Service(w movierepo.MovieWriter, r movierepo.MovieRepository, l languagerepo.LanguageRepository) *admin_service {
+	return &admin_service{writer: w, movies: New_Movie_Service(r), languages: l}
+}
+
+// writtenAppends are appended to the movie returned after a write, so the
+// caller sees the links it just stored.
+var writtenAppends = []string{"genres", "companies", "spoken_languages"}
+
+// CreateMovie stores a new movie under a fresh UUIDv7 and returns it.
+func (r admin_service) CreateMovie(ctx context.Context, w model.MovieWrite) (model.MovieResponse, error) {
+	if err := validateMovieWrite(w, false); err != nil {
+		return model.MovieResponse{}, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return model.MovieResponse{}, fmt.Errorf("service: new movie id: %w", err)
+	}
+	if err := r.writer.CreateMovie(ctx, id, w); err != nil {
+		return model.MovieResponse{}, fmt.Errorf("service: CreateMovie: %w", err)
+	}
+	return r.movies.GetMovieById(ctx, id.String(), "en", writtenAppends, model.AppendOptions{})
+}
+
+// ReplaceMovie overwrites a movie with w; fields left out are cleared.
+func (r admin_service) ReplaceMovie(ctx context.Context, id string, w model.MovieWrite) (model.MovieResponse, error) {
+	if err := validateMovieWrite(w, false); err != nil {
+		return model.MovieResponse{}, err
+	}
+	if err := r.writer.ReplaceMovie(ctx, id, w); err != nil {
+		return model.MovieResponse{}, fmt.Errorf("service: ReplaceMovie: %w", err)
+	}
+	return r.movies.GetMovieById(ctx, id, "en", writtenAppends, model.AppendOptions{})
+}
+
+// PatchMovie updates the fields set in w and keeps the others.
+func (r admin_service) PatchMovie(ctx context.Context, id string, w model.MovieWrite) (model.MovieResponse, error) {
+	if err := validateMovieWrite(w, true); err != nil {
+		return model.MovieResponse{}, err
+	}
+	if err := r.writer.PatchMovie(ctx, id, w); err != nil {
+		return model.MovieResponse{}, fmt.Errorf("service: PatchMovie: %w", err)
+	}
+	return r.movies.GetMovieById(ctx, id, "en", writtenAppends, model.AppendOptions{})
+}
+
+func (r admin_service) DeleteMovie(ctx context.Context, id string) error {
+	if err := r.writer.DeleteMovie(ctx, id); err != nil {
+		return fmt.Errorf("service: DeleteMovie: %w", err)
+	}
+	return nil
+}
+
+// PutTranslation inserts or replaces the movie's translation into lang,
+// which must be in the languages table. It reports whether the translation
+// is new.
+func (r admin_service) PutTranslation(ctx context.Context, id, lang string, t model.TranslationWrite) (model.MovieTranslation, bool, error) {
+	language, err := r.language(ctx, lang)
+	if err != nil {
+		return model.MovieTranslation{}, false, err
+	}
+	if t.Title == nil && t.Overview == nil {
+		verr := &model.ValidationError{}
+		verr.Add("title", "title or overview is required")
+		return model.MovieTranslation{}, false, verr
+	}
+
+	created, err := r.writer.PutTranslation(ctx, id, lang, t)
+	if err != nil {
+		return model.MovieTranslation{}, false, fmt.Errorf("service: PutTranslation: %w", err)
+	}
+	return model.MovieTranslation{
+		ISO6391:     language.ISO6391,
+		EnglishName: &language.EnglishName,
+		Name:        &language.Name,
+		Data:        model.TranslationData{Title: t.Title, Overview: t.Overview},
+	}, created, nil
+}
+
+func (r admin_service) DeleteTranslation(ctx context.Context, id, lang string) error {
+	if _, err := r.language(ctx, lang); err != nil {
+		return err
+	}
+	if err := r.writer.DeleteTranslation(ctx, id, lang); err != nil {
+		return fmt.Errorf("service: DeleteTranslation: %w", err)
+	}
+	return nil
+}
+
+// language looks lang up, turning an unknown code into a validation error.
+func (r admin_service) language(ctx context.Context, lang string) (model.Language, error) {
+	l, err := r.languages.GetLanguage(ctx, lang)
+	if errors.Is(err, sql.ErrNoRows) {
+		verr := &model.ValidationError{}
+		verr.Add("lang", "is not a known language")
+		return model.Language{}, verr
+	}
+	if err != nil {
+		return model.Language{}, fmt.Errorf("service: GetLanguage: %w", err)
+	}
+	return l, nil
+}
+
+// validateMovieWrite checks everything that can be checked without the
+// store. Only a partial (PATCH) write may leave out the title.
+func validateMovieWrite(w model.MovieWrite, partial bool) error {
+	verr := &model.ValidationError{}
+
+	if w.Title == nil {
+		if !partial {
+			verr.Add("title", "is required")
+		}
+	} else if strings.TrimSpace(*w.Title) == "" {
+		verr.Add("title", "must not be blank")
+	}
+	if w.OriginalLanguage != nil && (*w.OriginalLanguage == "" || len(*w.OriginalLanguage) > 10) {
+		verr.Add("original_language", "must be a language code of at most 10 characters")
+	}
+	if w.ReleaseDate != nil {
+		if _, err := time.Parse(time.DateOnly, *w.ReleaseDate); err != nil {
+			verr.Add("release_date", "must be a date formatted as YYYY-MM-DD")
+		}
+	}
+	if w.Runtime != nil && *w.Runtime < 0 {
+		verr.Add("runtime", "must not be negative")
+	}
+	if w.Budget != nil && *w.Budget < 0 {
+		verr.Add("budget", "must not be negative")
+	}
+	if w.Revenue != nil && *w.Revenue < 0 {
+		verr.Add("revenue", "must not be negative")
+	}
+	if w.Popularity != nil && *w.Popularity < 0 {
+		verr.Add("popularity", "must not be negative")
+	}
+
+	if w.GenreIDs != nil {
+		validateIDs(verr, "genre_ids", *w.GenreIDs)
+	}
+	if w.CompanyIDs != nil {
+		validateIDs(verr, "company_ids", *w.CompanyIDs)
+	}
+	if w.SpokenLanguages != nil {
+		seen := make(map[string]bool)
+		for _, iso := range *w.SpokenLanguages {
+			if iso == "" {
+				verr.Add("spoken_languages", "must not contain empty codes")
+				break
+			}
+			if seen[iso] {
+				verr.Add("spoken_languages", "must not contain duplicates")
+				break
+			}
+			seen[iso] = true
+		}
+	}
+
+	return verr.OrNil()
+}
+
+func validateIDs(verr *model.ValidationError, field string, ids []uuid.UUID) {
+	seen := make(map[uuid.UUID]bool)
+	for _, id := range ids {
+		if id.IsNil() {
+			verr.Add(field, "must not contain the nil uuid")
+			return
+		}
+		if seen[id] {
+			verr.Add(field, "must not contain duplicates")
+			return
+		}
+		seen[id] = true
+	}
+}
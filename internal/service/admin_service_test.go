@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	memoryrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/memory_repo"
+)
+
+func newAdminTestService(t *testing.T) (*admin_service, *fixtures.Dataset) {
+	t.Helper()
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	return New_Admin_Service(mem, mem, mem), ds
+}
+
+func TestCreateMovie_MemoryRepo(t *testing.T) {
+	svc, ds := newAdminTestService(t)
+	genres := []uuid.UUID{ds.Genres[0].ID}
+	languages := []string{"en", "fr"}
+
+	res, err := svc.CreateMovie(context.Background(), model.MovieWrite{
+		Title:           ptr("New Movie"),
+		ReleaseDate:     ptr("2026-05-01"),
+		GenreIDs:        &genres,
+		SpokenLanguages: &languages,
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if res.ID.IsNil() || res.Title != "New Movie" {
+		t.Errorf("expected the created movie back, got %+v", res)
+	}
+	if len(res.Genres) != 1 || res.Genres[0].ID != ds.Genres[0].ID {
+		t.Errorf("expected the genre link, got %v", res.Genres)
+	}
+	if len(res.SpokenLanguages) != 2 {
+		t.Errorf("expected 2 spoken languages, got %v", res.SpokenLanguages)
+	}
+	if res.Budget == nil || *res.Budget != 0 {
+		t.Errorf("expected budget to default to 0, got %v", res.Budget)
+	}
+}
+
+func TestCreateMovie_Validation(t *testing.T) {
+	svc, _ := newAdminTestService(t)
+
+	_, err := svc.CreateMovie(context.Background(), model.MovieWrite{
+		ReleaseDate: ptr("01/05/2026"),
+		Popularity:  ptr(-1.0),
+	})
+
+	var verr *model.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	fields := make(map[string]bool)
+	for _, f := range verr.Errors {
+		fields[f.Field] = true
+	}
+	for _, f := range []string{"title", "release_date", "popularity"} {
+		if !fields[f] {
+			t.Errorf("expected an error for %s, got %v", f, verr.Errors)
+		}
+	}
+}
+
+func TestCreateMovie_UnknownReference(t *testing.T) {
+	svc, _ := newAdminTestService(t)
+	languages := []string{"xx"}
+
+	_, err := svc.CreateMovie(context.Background(), model.MovieWrite{Title: ptr("New Movie"), SpokenLanguages: &languages})
+
+	var verr *model.ValidationError
+	if !errors.As(err, &verr) || verr.Errors[0].Field != "spoken_languages" {
+		t.Errorf("expected a spoken_languages validation error, got %v", err)
+	}
+}
+
+func TestPatchMovie_KeepsOtherFields(t *testing.T) {
+	svc, ds := newAdminTestService(t)
+	movie := ds.Movies[0]
+
+	res, err := svc.PatchMovie(context.Background(), movie.ID.String(), model.MovieWrite{Overview: ptr("Patched")})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if *res.Overview != "Patched" {
+		t.Errorf("expected the new overview, got %s", *res.Overview)
+	}
+	if res.Title != movie.Title || len(res.Genres) != len(movie.GenreIDs) {
+		t.Errorf("expected title and genres to be kept, got %s with %d genres", res.Title, len(res.Genres))
+	}
+}
+
+func TestReplaceMovie_ClearsOmittedFields(t *testing.T) {
+	svc, ds := newAdminTestService(t)
+	movie := ds.Movies[0]
+
+	res, err := svc.ReplaceMovie(context.Background(), movie.ID.String(), model.MovieWrite{Title: ptr("Replaced")})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if res.Title != "Replaced" || res.ReleaseDate != nil {
+		t.Errorf("expected only the title to remain, got %+v", res)
+	}
+	if len(res.Genres) != 0 || len(res.ProductionCompanies) != 0 {
+		t.Errorf("expected links to be cleared, got %v and %v", res.Genres, res.ProductionCompanies)
+	}
+}
+
+func TestUpdateMovie_NotFound(t *testing.T) {
+	svc, _ := newAdminTestService(t)
+	id := uuid.Must(uuid.NewV7()).String()
+
+	_, err := svc.PatchMovie(context.Background(), id, model.MovieWrite{Title: ptr("x")})
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows from patch, got %v", err)
+	}
+	if err := svc.DeleteMovie(context.Background(), id); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows from delete, got %v", err)
+	}
+}
+
+func TestDeleteMovie_MemoryRepo(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	svc := New_Admin_Service(mem, mem, mem)
+	id := ds.Movies[0].ID.String()
+
+	if err := svc.DeleteMovie(context.Background(), id); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, err := New_Movie_Service(mem).GetMovieById(context.Background(), id, "en", nil, model.AppendOptions{})
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected the movie to be gone, got %v", err)
+	}
+}
+
+func TestPutTranslation_MemoryRepo(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	svc := New_Admin_Service(mem, mem, mem)
+	movies := New_Movie_Service(mem)
+	matrix := ds.Movies[0]
+	for _, m := range ds.Movies {
+		if m.Title == "The Matrix" {
+			matrix = m
+		}
+	}
+	ctx := context.Background()
+
+	res, created, err := svc.PutTranslation(ctx, matrix.ID.String(), "de", model.TranslationWrite{Title: ptr("Matrix"), Overview: ptr("Ein Hacker erfährt die Wahrheit.")})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !created || res.EnglishName == nil || *res.EnglishName != "German" {
+		t.Errorf("expected a new German translation, got %v %+v", created, res)
+	}
+
+	movie, _ := movies.GetMovieById(ctx, matrix.ID.String(), "de", nil, model.AppendOptions{})
+	if movie.Title != "Matrix" {
+		t.Errorf("expected the translated title, got %s", movie.Title)
+	}
+	search, _ := movies.SearchMovie(ctx, "Hacker", "de", false, sql.NullInt64{}, sql.NullString{}, 1, 20, false, "")
+	if len(search.Results) == 0 || search.Results[0].ID != matrix.ID {
+		t.Errorf("expected the translation to be searchable, got %+v", search.Results)
+	}
+
+	_, created, err = svc.PutTranslation(ctx, matrix.ID.String(), "de", model.TranslationWrite{Title: ptr("Matrix (1999)")})
+	if err != nil || created {
+		t.Errorf("expected an update, got created %v err %v", created, err)
+	}
+
+	if err := svc.DeleteTranslation(ctx, matrix.ID.String(), "de"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	movie, _ = movies.GetMovieById(ctx, matrix.ID.String(), "de", nil, model.AppendOptions{})
+	if movie.Title != "The Matrix" {
+		t.Errorf("expected the base title after delete, got %s", movie.Title)
+	}
+	if err := svc.DeleteTranslation(ctx, matrix.ID.String(), "de"); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows deleting twice, got %v", err)
+	}
+}
+
+func TestPutTranslation_Validation(t *testing.T) {
+	svc, ds := newAdminTestService(t)
+	id := ds.Movies[0].ID.String()
+
+	var verr *model.ValidationError
+	_, _, err := svc.PutTranslation(context.Background(), id, "xx", model.TranslationWrite{Title: ptr("x")})
+	if !errors.As(err, &verr) || verr.Errors[0].Field != "lang" {
+		t.Errorf("expected a lang validation error, got %v", err)
+	}
+	_, _, err = svc.PutTranslation(context.Background(), id, "de", model.TranslationWrite{})
+	if !errors.As(err, &verr) {
+		t.Errorf("expected a validation error for an empty body, got %v", err)
+	}
+	_, _, err = svc.PutTranslation(context.Background(), uuid.Must(uuid.NewV7()).String(), "de", model.TranslationWrite{Title: ptr("x")})
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows for an unknown movie, got %v", err)
+	}
+}
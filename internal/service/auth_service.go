@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/auth"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	userrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/user_repo"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	minPasswordLength = 8
+	maxPasswordLength = 72 // bcrypt ignores anything after 72 bytes
+)
+
+// ErrInvalidCredentials is returned by Login for an unknown email or a wrong
+// password, without telling which.
+var ErrInvalidCredentials = errors.New("invalid email or password")
+
+type Auth_Service interface {
+	Register(ctx context.Context, creds model.Credentials) (model.AuthResponse, error)
+	Login(ctx context.Context, creds model.Credentials) (model.AuthResponse, error)
+	Refresh(ctx context.Context, refreshToken string) (model.TokenPair, error)
+	Authenticate(accessToken string) (uuid.UUID, error)
+	GetAccount(ctx context.Context, userID uuid.UUID) (model.User, error)
+}
+
+type auth_service struct {
+	users  userrepo.UserRepository
+	tokens *auth.Tokens
+	cost   int // bcrypt cost
+
+	// dummyHash is compared against for unknown emails, so they take as
+	// long to reject as wrong passwords.
+	dummyHash []byte
+}
+
+func New_Auth_Service(u userrepo.UserRepository, t *auth.Tokens) *auth_service {
+	dummy, _ := bcrypt.GenerateFromPassword([]byte("no password matches this"), bcrypt.DefaultCost)
+	return &auth_service{users: u, tokens: t, cost: bcrypt.DefaultCost, dummyHash: dummy}
+}
+
+// Register creates an account and signs the user in. A taken email returns
+// userrepo.ErrEmailTaken.
+func (r auth_service) Register(ctx context.Context, creds model.Credentials) (model.AuthResponse, error) {
+	email := normalizeEmail(creds.Email)
+	if err := validateCredentials(email, creds.Password); err != nil {
+		return model.AuthResponse{}, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(creds.Password), r.cost)
+	if err != nil {
+		return model.AuthResponse{}, fmt.Errorf("service: hash password: %w", err)
+	}
+	id, err := uuid.NewV7()
+	if err != nil {
+		return model.AuthResponse{}, fmt.Errorf("service: new user id: %w", err)
+	}
+	u := model.User{ID: id, Email: email, PasswordHash: string(hash), CreatedAt: time.Now().UTC()}
+	if err := r.users.CreateUser(ctx, u); err != nil {
+		return model.AuthResponse{}, fmt.Errorf("service: CreateUser: %w", err)
+	}
+	return r.signIn(u)
+}
+
+// Login checks the password of the account registered under creds.Email.
+func (r auth_service) Login(ctx context.Context, creds model.Credentials) (model.AuthResponse, error) {
+	u, err := r.users.GetUserByEmail(ctx, normalizeEmail(creds.Email))
+	if errors.Is(err, sql.ErrNoRows) {
+		bcrypt.CompareHashAndPassword(r.dummyHash, []byte(creds.Password))
+		return model.AuthResponse{}, ErrInvalidCredentials
+	}
+	if err != nil {
+		return model.AuthResponse{}, fmt.Errorf("service: GetUserByEmail: %w", err)
+	}
+	if bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(creds.Password)) != nil {
+		return model.AuthResponse{}, ErrInvalidCredentials
+	}
+	return r.signIn(u)
+}
+
+// Refresh exchanges a refresh token for a new token pair. Invalid tokens,
+// and tokens of users that no longer exist, return auth.ErrInvalidToken.
+func (r auth_service) Refresh(ctx context.Context, refreshToken string) (model.TokenPair, error) {
+	userID, err := r.tokens.Verify(refreshToken, auth.RefreshToken)
+	if err != nil {
+		return model.TokenPair{}, err
+	}
+	if _, err := r.users.GetUserById(ctx, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.TokenPair{}, fmt.Errorf("%w: unknown user", auth.ErrInvalidToken)
+		}
+		return model.TokenPair{}, fmt.Errorf("service: GetUserById: %w", err)
+	}
+	return r.tokens.Issue(userID)
+}
+
+// Authenticate returns the user an access token was issued to.
+func (r auth_service) Authenticate(accessToken string) (uuid.UUID, error) {
+	return r.tokens.Verify(accessToken, auth.AccessToken)
+}
+
+func (r auth_service) GetAccount(ctx context.Context, userID uuid.UUID) (model.User, error) {
+	u, err := r.users.GetUserById(ctx, userID)
+	if err != nil {
+		return model.User{}, fmt.Errorf("service: GetUserById: %w", err)
+	}
+	return u, nil
+}
+
+func (r auth_service) signIn(u model.User) (model.AuthResponse, error) {
+	tokens, err := r.tokens.Issue(u.ID)
+	if err != nil {
+		return model.AuthResponse{}, fmt.Errorf("service: issue tokens: %w", err)
+	}
+	return model.AuthResponse{User: u, TokenPair: tokens}, nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func validateCredentials(email, password string) error {
+	verr := &model.ValidationError{}
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		verr.Add("email", "must be a valid email address")
+	}
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		verr.Add("password", fmt.Sprintf("must be between %d and %d bytes", minPasswordLength, maxPasswordLength))
+	}
+	return verr.OrNil()
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/auth"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	memoryrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/memory_repo"
+	userrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/user_repo"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newTestAuthService() *auth_service {
+	svc := New_Auth_Service(memoryrepo.New_Memory_Repo(nil), auth.NewTokens([]byte("secret"), time.Minute, time.Hour))
+	svc.cost = bcrypt.MinCost
+	return svc
+}
+
+func TestRegisterAndLogin(t *testing.T) {
+	svc := newTestAuthService()
+	ctx := context.Background()
+
+	reg, err := svc.Register(ctx, model.Credentials{Email: " Ada@Example.com ", Password: "correct horse"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if reg.User.Email != "ada@example.com" || reg.User.ID.IsNil() || reg.AccessToken == "" || reg.RefreshToken == "" {
+		t.Fatalf("unexpected registration %+v", reg)
+	}
+	if reg.User.PasswordHash == "correct horse" {
+		t.Error("expected the password to be hashed")
+	}
+
+	if _, err := svc.Register(ctx, model.Credentials{Email: "ADA@example.com", Password: "another one"}); !errors.Is(err, userrepo.ErrEmailTaken) {
+		t.Errorf("expected ErrEmailTaken, got %v", err)
+	}
+
+	login, err := svc.Login(ctx, model.Credentials{Email: "ada@EXAMPLE.com", Password: "correct horse"})
+	if err != nil || login.User.ID != reg.User.ID {
+		t.Fatalf("expected to log in as %s, got %+v, %v", reg.User.ID, login.User, err)
+	}
+	userID, err := svc.Authenticate(login.AccessToken)
+	if err != nil || userID != reg.User.ID {
+		t.Errorf("expected the access token to authenticate %s, got %s, %v", reg.User.ID, userID, err)
+	}
+
+	for _, creds := range []model.Credentials{
+		{Email: "ada@example.com", Password: "wrong horse"},
+		{Email: "nobody@example.com", Password: "correct horse"},
+	} {
+		if _, err := svc.Login(ctx, creds); !errors.Is(err, ErrInvalidCredentials) {
+			t.Errorf("%s: expected ErrInvalidCredentials, got %v", creds.Email, err)
+		}
+	}
+}
+
+func TestRegister_Validation(t *testing.T) {
+	svc := newTestAuthService()
+
+	_, err := svc.Register(context.Background(), model.Credentials{Email: "Ada <ada@example.com>", Password: "short"})
+
+	var verr *model.ValidationError
+	if !errors.As(err, &verr) || len(verr.Errors) != 2 {
+		t.Fatalf("expected email and password errors, got %v", err)
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	svc := newTestAuthService()
+	ctx := context.Background()
+	reg, _ := svc.Register(ctx, model.Credentials{Email: "ada@example.com", Password: "correct horse"})
+
+	pair, err := svc.Refresh(ctx, reg.RefreshToken)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if userID, err := svc.Authenticate(pair.AccessToken); err != nil || userID != reg.User.ID {
+		t.Errorf("expected a new access token for %s, got %s, %v", reg.User.ID, userID, err)
+	}
+
+	if _, err := svc.Refresh(ctx, reg.AccessToken); !errors.Is(err, auth.ErrInvalidToken) {
+		t.Errorf("expected an access token to be refused, got %v", err)
+	}
+
+	// a token of a user the store doesn't know
+	other := newTestAuthService()
+	other.tokens = svc.tokens
+	if _, err := other.Refresh(ctx, reg.RefreshToken); !errors.Is(err, auth.ErrInvalidToken) {
+		t.Errorf("expected an unknown user to be refused, got %v", err)
+	}
+}
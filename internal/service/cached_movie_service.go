@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/auth"
+	"github.com/h-raju-arch/movie_app_backend/internal/cache"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// CacheTTLs is how long each Movie_Service method's responses are cached.
+// A zero TTL leaves that method uncached.
+type CacheTTLs struct {
+	Movie        time.Duration // GetMovieById
+	Translations time.Duration
+	Similar      time.Duration
+	Search       time.Duration
+	Discover     time.Duration
+}
+
+var DefaultCacheTTLs = CacheTTLs{
+	Movie:        10 * time.Minute,
+	Translations: 10 * time.Minute,
+	Similar:      10 * time.Minute,
+	Search:       time.Minute,
+	Discover:     time.Minute,
+}
+
+// CacheCounts are the hits and misses of one cached method. Calls that
+// bypass the cache count as neither.
+type CacheCounts struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+}
+
+// Cached method names, as Stats reports them.
+const (
+	cacheGetMovieById    = "GetMovieById"
+	cacheGetTranslations = "GetTranslations"
+	cacheGetSimilar      = "GetSimilar"
+	cacheSearchMovie     = "SearchMovie"
+	cacheDiscover        = "Discover"
+)
+
+// cacheKeyPrefix namespaces the keys in a backend shared with others.
+const cacheKeyPrefix = "movie_app:"
+
+// Cache_Invalidator evicts cached movie service responses after writes.
+type Cache_Invalidator interface {
+	// InvalidateMovie evicts the movie's cached details, translations and
+	// similar movies. Lists it appears in are left to InvalidateLists.
+	InvalidateMovie(ctx context.Context, id string) error
+	// InvalidateLists evicts every cached search, discover and similar
+	// page, and the movies cached with the similar append.
+	InvalidateLists(ctx context.Context) error
+	// InvalidateAll evicts every cached response, for writes to movies
+	// that aren't known one by one, like an import.
+	InvalidateAll(ctx context.Context) error
+}
+
+// Cache_Stats reports the response cache's hit and miss counts so far, by
+// method name.
+type Cache_Stats interface {
+	Stats() map[string]CacheCounts
+}
+
+type cacheCounter struct {
+	hits, misses atomic.Uint64
+}
+
+// cached_movie_service is a read-through cache in front of another
+// Movie_Service. Responses are stored as JSON so any cache.Backend can hold
+// them; errors are never cached.
+//
+// Entries are invalidated by generation rather than deleted one by one (see
+// cache_invalidator).
+type cached_movie_service struct {
+	cache_invalidator
+	next     Movie_Service
+	ttls     CacheTTLs
+	counters map[string]*cacheCounter
+}
+
+func New_Cached_Movie_Service(next Movie_Service, backend cache.Backend, ttls CacheTTLs) *cached_movie_service {
+	counters := make(map[string]*cacheCounter)
+	for _, m := range []string{cacheGetMovieById, cacheGetTranslations, cacheGetSimilar, cacheSearchMovie, cacheDiscover} {
+		counters[m] = &cacheCounter{}
+	}
+	return &cached_movie_service{cache_invalidator: cache_invalidator{backend: backend}, next: next, ttls: ttls, counters: counters}
+}
+
+// GetMovieById bypasses the cache for signed-in callers asking for
+// account_states or reviews, which differ from user to user.
+func (r *cached_movie_service) GetMovieById(ctx context.Context, id, lang string, appendtoresponse []string, opts model.AppendOptions) (model.MovieResponse, error) {
+	fetch := func() (model.MovieResponse, error) {
+		return r.next.GetMovieById(ctx, id, lang, appendtoresponse, opts)
+	}
+	if _, ok := auth.UserID(ctx); ok && (slices.Contains(appendtoresponse, "account_states") || slices.Contains(appendtoresponse, "reviews")) {
+		return fetch()
+	}
+
+	appends := slices.Clone(appendtoresponse)
+	slices.Sort(appends)
+	appends = slices.Compact(appends)
+	gens := r.movieGenerations(ctx, id)
+	if slices.Contains(appends, "similar") {
+		gens = append(gens, r.listsGeneration(ctx))
+	}
+
+	key := r.key(cacheGetMovieById, "movie:"+id, gens, struct {
+		Lang    string
+		Appends []string
+		Opts    model.AppendOptions
+	}{lang, appends, opts})
+	return readThrough(ctx, r, cacheGetMovieById, key, r.ttls.Movie, fetch)
+}
+
+func (r *cached_movie_service) GetTranslations(ctx context.Context, id string) (model.TranslationsResponse, error) {
+	key := r.key(cacheGetTranslations, "movie:"+id, r.movieGenerations(ctx, id), nil)
+	return readThrough(ctx, r, cacheGetTranslations, key, r.ttls.Translations, func() (model.TranslationsResponse, error) {
+		return r.next.GetTranslations(ctx, id)
+	})
+}
+
+func (r *cached_movie_service) GetSimilar(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) (model.DiscoverMoviesResponse, error) {
+	gens := append(r.movieGenerations(ctx, id), r.listsGeneration(ctx))
+	key := r.key(cacheGetSimilar, "movie:"+id, gens, struct {
+		Lang           string
+		IncludeAdult   bool
+		Page, PageSize int
+	}{lang, includeAdult, page, pageSize})
+	return readThrough(ctx, r, cacheGetSimilar, key, r.ttls.Similar, func() (model.DiscoverMoviesResponse, error) {
+		return r.next.GetSimilar(ctx, id, lang, includeAdult, page, pageSize)
+	})
+}
+
+func (r *cached_movie_service) SearchMovie(ctx context.Context, searchQuery string, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int, fuzzy bool, cursor string) (model.SearchResponse, error) {
+	key := r.key(cacheSearchMovie, "lists", []string{r.listsGeneration(ctx)}, struct {
+		Query, Language string
+		IncludeAdult    bool
+		PrimaryYear     sql.NullInt64
+		Region          sql.NullString
+		Page, PageSize  int
+		Fuzzy           bool
+		Cursor          string
+	}{searchQuery, language, includeAdult, primaryYear, region, page, pageSize, fuzzy, cursor})
+	return readThrough(ctx, r, cacheSearchMovie, key, r.ttls.Search, func() (model.SearchResponse, error) {
+		return r.next.SearchMovie(ctx, searchQuery, language, includeAdult, primaryYear, region, page, pageSize, fuzzy, cursor)
+	})
+}
+
+func (r *cached_movie_service) Discover(ctx context.Context, params model.DiscoverMoviesParams) (model.DiscoverMoviesResponse, error) {
+	key := r.key(cacheDiscover, "lists", []string{r.listsGeneration(ctx)}, params)
+	return readThrough(ctx, r, cacheDiscover, key, r.ttls.Discover, func() (model.DiscoverMoviesResponse, error) {
+		return r.next.Discover(ctx, params)
+	})
+}
+
+// Stats returns the hit and miss counts so far, by method name.
+func (r *cached_movie_service) Stats() map[string]CacheCounts {
+	stats := make(map[string]CacheCounts, len(r.counters))
+	for m, c := range r.counters {
+		stats[m] = CacheCounts{Hits: c.hits.Load(), Misses: c.misses.Load()}
+	}
+	return stats
+}
+
+// readThrough returns the response cached under key, or fetches and caches
+// it. Backend failures are logged and fall through to fetch, so the cache
+// can't take the API down with it.
+func readThrough[T any](ctx context.Context, r *cached_movie_service, method, key string, ttl time.Duration, fetch func() (T, error)) (T, error) {
+	if ttl <= 0 {
+		return fetch()
+	}
+	counter := r.counters[method]
+
+	if b, ok, err := r.backend.Get(ctx, key); err != nil {
+		log.Println("cache: get:", err)
+	} else if ok {
+		var res T
+		if err := json.Unmarshal(b, &res); err == nil {
+			counter.hits.Add(1)
+			return res, nil
+		}
+	}
+	counter.misses.Add(1)
+
+	res, err := fetch()
+	if err != nil {
+		return res, err
+	}
+	if b, err := json.Marshal(res); err != nil {
+		log.Println("cache: encode", method, "response:", err)
+	} else if err := r.backend.Set(ctx, key, b, ttl); err != nil {
+		log.Println("cache: set:", err)
+	}
+	return res, nil
+}
+
+// key is the backend key of a call: the method, the scope it is
+// invalidated with, the scope's current generations and a hash of the
+// call's arguments.
+func (r *cached_movie_service) key(method, scope string, gens []string, args any) string {
+	b, _ := json.Marshal(args)
+	sum := sha256.Sum256(b)
+	key := cacheKeyPrefix + scope + ":" + method
+	for _, g := range gens {
+		key += ":" + g
+	}
+	return key + ":" + hex.EncodeToString(sum[:16])
+}
+
+// cache_invalidator invalidates cached responses by generation rather than
+// deleting them one by one: every movie, all movies together and all lists
+// together have a generation token stored in the backend and part of their
+// keys. Invalidating replaces the token, so later lookups miss and the old
+// entries age out. Keeping the tokens in the backend makes invalidation
+// reach every server sharing it, including from another process.
+type cache_invalidator struct {
+	backend cache.Backend
+}
+
+// New_Cache_Invalidator invalidates the responses a cached movie service
+// stores in backend, for processes that write movies without serving them.
+func New_Cache_Invalidator(backend cache.Backend) *cache_invalidator {
+	return &cache_invalidator{backend: backend}
+}
+
+func (r *cache_invalidator) InvalidateMovie(ctx context.Context, id string) error {
+	return r.bump(ctx, "gen:movie:"+id)
+}
+
+func (r *cache_invalidator) InvalidateLists(ctx context.Context) error {
+	return r.bump(ctx, "gen:lists")
+}
+
+func (r *cache_invalidator) InvalidateAll(ctx context.Context) error {
+	if err := r.bump(ctx, "gen:movies"); err != nil {
+		return err
+	}
+	return r.bump(ctx, "gen:lists")
+}
+
+// movieGenerations are the generations of the movie's own entries: its own
+// and that of all movies.
+func (r *cache_invalidator) movieGenerations(ctx context.Context, id string) []string {
+	return []string{r.generation(ctx, "gen:movies"), r.generation(ctx, "gen:movie:"+id)}
+}
+
+func (r *cache_invalidator) listsGeneration(ctx context.Context) string {
+	return r.generation(ctx, "gen:lists")
+}
+
+// generation returns the token stored under name. A missing token (never
+// bumped, or evicted) is replaced by a fresh one rather than defaulted, so
+// an evicted token can't bring back the entries it retired.
+func (r *cache_invalidator) generation(ctx context.Context, name string) string {
+	b, ok, err := r.backend.Get(ctx, cacheKeyPrefix+name)
+	if err != nil {
+		log.Println("cache: get generation:", err)
+		return newGeneration()
+	}
+	if ok {
+		return string(b)
+	}
+	token := newGeneration()
+	if err := r.backend.Set(ctx, cacheKeyPrefix+name, []byte(token), 0); err != nil {
+		log.Println("cache: set generation:", err)
+	}
+	return token
+}
+
+// bump replaces the generation token stored under name with a fresh one.
+func (r *cache_invalidator) bump(ctx context.Context, name string) error {
+	if err := r.backend.Set(ctx, cacheKeyPrefix+name, []byte(newGeneration()), 0); err != nil {
+		return fmt.Errorf("service: invalidate %s: %w", name, err)
+	}
+	return nil
+}
+
+func newGeneration() string {
+	return uuid.Must(uuid.NewV7()).String()
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/auth"
+	"github.com/h-raju-arch/movie_app_backend/internal/cache"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	memoryrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/memory_repo"
+)
+
+// countingMovieService counts the calls that reach the wrapped service.
+type countingMovieService struct {
+	Movie_Service
+	ds    *fixtures.Dataset
+	calls map[string]int
+}
+
+func newCountingMovieService() *countingMovieService {
+	ds := fixtures.Build(time.Now())
+	return &countingMovieService{Movie_Service: New_Movie_Service(memoryrepo.New_Memory_Repo(ds)), ds: ds, calls: make(map[string]int)}
+}
+
+func (s *countingMovieService) GetMovieById(ctx context.Context, id, lang string, appends []string, opts model.AppendOptions) (model.MovieResponse, error) {
+	s.calls[cacheGetMovieById]++
+	return s.Movie_Service.GetMovieById(ctx, id, lang, appends, opts)
+}
+
+func (s *countingMovieService) Discover(ctx context.Context, params model.DiscoverMoviesParams) (model.DiscoverMoviesResponse, error) {
+	s.calls[cacheDiscover]++
+	return s.Movie_Service.Discover(ctx, params)
+}
+
+func (s *countingMovieService) GetSimilar(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) (model.DiscoverMoviesResponse, error) {
+	s.calls[cacheGetSimilar]++
+	return s.Movie_Service.GetSimilar(ctx, id, lang, includeAdult, page, pageSize)
+}
+
+func TestCachedMovieService_ReadThrough(t *testing.T) {
+	next := newCountingMovieService()
+	svc := New_Cached_Movie_Service(next, cache.NewMemory(100), DefaultCacheTTLs)
+	ctx := context.Background()
+	id := next.ds.Movies[0].ID.String()
+
+	first, err := svc.GetMovieById(ctx, id, "en", []string{"genres", "credits"}, model.AppendOptions{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// appends in another order, or repeated, are the same call
+	second, _ := svc.GetMovieById(ctx, id, "en", []string{"credits", "genres", "credits"}, model.AppendOptions{})
+	if next.calls[cacheGetMovieById] != 1 {
+		t.Errorf("expected 1 call through, got %d", next.calls[cacheGetMovieById])
+	}
+	if second.Title != first.Title || len(second.Credits.Cast) != len(first.Credits.Cast) || len(second.Genres) != len(first.Genres) {
+		t.Errorf("expected the cached response to match, got %+v", second)
+	}
+
+	svc.GetMovieById(ctx, id, "es", []string{"genres", "credits"}, model.AppendOptions{})
+	svc.GetMovieById(ctx, id, "en", []string{"genres", "credits"}, model.AppendOptions{IncludeAdult: true})
+	if next.calls[cacheGetMovieById] != 3 {
+		t.Errorf("expected other languages and options to miss, got %d calls", next.calls[cacheGetMovieById])
+	}
+
+	params := model.DiscoverMoviesParams{SortBy: "vote_average.desc", PageSize: 5}
+	svc.Discover(ctx, params)
+	svc.Discover(ctx, params)
+	params.VoteAvgGTE = ptr(8.0)
+	svc.Discover(ctx, params)
+	if next.calls[cacheDiscover] != 2 {
+		t.Errorf("expected every discover param in the key, got %d calls", next.calls[cacheDiscover])
+	}
+
+	stats := svc.Stats()
+	if stats[cacheGetMovieById] != (CacheCounts{Hits: 1, Misses: 3}) || stats[cacheDiscover] != (CacheCounts{Hits: 1, Misses: 2}) {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestCachedMovieService_Bypass(t *testing.T) {
+	next := newCountingMovieService()
+	svc := New_Cached_Movie_Service(next, cache.NewMemory(100), CacheTTLs{Movie: time.Minute})
+	id := next.ds.Movies[0].ID.String()
+	user := auth.WithUserID(context.Background(), uuid.Must(uuid.NewV7()))
+
+	// per-user appends for a signed-in caller
+	for range 2 {
+		svc.GetMovieById(user, id, "en", []string{"account_states"}, model.AppendOptions{})
+	}
+	// errors aren't cached
+	missing := uuid.Must(uuid.NewV7()).String()
+	for range 2 {
+		if _, err := svc.GetMovieById(context.Background(), missing, "en", nil, model.AppendOptions{}); !errors.Is(err, sql.ErrNoRows) {
+			t.Fatalf("expected sql.ErrNoRows, got %v", err)
+		}
+	}
+	// a zero ttl leaves the method uncached
+	for range 2 {
+		svc.GetSimilar(context.Background(), id, "en", false, 1, 20)
+	}
+
+	if next.calls[cacheGetMovieById] != 4 || next.calls[cacheGetSimilar] != 2 {
+		t.Errorf("expected every call through, got %v", next.calls)
+	}
+	if stats := svc.Stats(); stats[cacheGetMovieById].Hits != 0 || stats[cacheGetSimilar] != (CacheCounts{}) {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestCachedMovieService_Invalidate(t *testing.T) {
+	next := newCountingMovieService()
+	ctx := context.Background()
+
+	// the same through a Redis client, against the RESP stand-in
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer l.Close()
+	go cache.ServeRESP(l, cache.NewMemory(100))
+	redis := cache.NewRedis(l.Addr().String(), 2)
+	defer redis.Close()
+
+	for name, backend := range map[string]cache.Backend{"memory": cache.NewMemory(100), "redis": redis} {
+		t.Run(name, func(t *testing.T) {
+			next.calls = make(map[string]int)
+			svc := New_Cached_Movie_Service(next, backend, DefaultCacheTTLs)
+			id := next.ds.Movies[0].ID.String()
+			params := model.DiscoverMoviesParams{PageSize: 5}
+			read := func() {
+				svc.GetMovieById(ctx, id, "en", nil, model.AppendOptions{})
+				svc.GetSimilar(ctx, id, "en", false, 1, 20)
+				svc.Discover(ctx, params)
+			}
+
+			read()
+			read()
+			want := map[string]int{cacheGetMovieById: 1, cacheGetSimilar: 1, cacheDiscover: 1}
+			if fmt.Sprint(next.calls) != fmt.Sprint(want) {
+				t.Fatalf("expected one call each, got %v", next.calls)
+			}
+
+			if err := svc.InvalidateMovie(ctx, id); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			read()
+			want = map[string]int{cacheGetMovieById: 2, cacheGetSimilar: 2, cacheDiscover: 1}
+			if fmt.Sprint(next.calls) != fmt.Sprint(want) {
+				t.Errorf("expected the movie's entries evicted, got %v", next.calls)
+			}
+
+			svc.InvalidateLists(ctx)
+			read()
+			want = map[string]int{cacheGetMovieById: 2, cacheGetSimilar: 3, cacheDiscover: 2}
+			if fmt.Sprint(next.calls) != fmt.Sprint(want) {
+				t.Errorf("expected the lists evicted, got %v", next.calls)
+			}
+
+			// another process sharing the backend, like an import
+			if err := New_Cache_Invalidator(backend).InvalidateAll(ctx); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			read()
+			want = map[string]int{cacheGetMovieById: 3, cacheGetSimilar: 4, cacheDiscover: 3}
+			if fmt.Sprint(next.calls) != fmt.Sprint(want) {
+				t.Errorf("expected everything evicted, got %v", next.calls)
+			}
+		})
+	}
+}
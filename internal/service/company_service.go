@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	companyrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/company_repo"
+)
+
+type Company_Service interface {
+	GetCompanyById(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) (model.CompanyResponse, error)
+}
+
+type company_service struct {
+	repo companyrepo.CompanyRepository
+}
+
+func New_Company_Service(r companyrepo.CompanyRepository) *company_service {
+	return &company_service{repo: r}
+}
+
+// GetCompanyById returns the company together with one page of the movies it
+// produced, newest first.
+func (r company_service) GetCompanyById(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) (model.CompanyResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	company, err := r.repo.GetCompanyById(ctx, id)
+	if err != nil {
+		return model.CompanyResponse{}, fmt.Errorf("service: Get company: %w", err)
+	}
+
+	movies, total, err := r.repo.FetchCompanyMovies(ctx, id, lang, includeAdult, page, pageSize)
+	if err != nil {
+		return model.CompanyResponse{}, fmt.Errorf("service: FetchCompanyMovies: %w", err)
+	}
+
+	company.Movies = &model.DiscoverMoviesResponse{
+		Page:         page,
+		PageSize:     pageSize,
+		TotalResults: total,
+		Results:      movies,
+	}
+	if total > 0 {
+		company.Movies.TotalPages = int(math.Ceil(float64(total) / float64(pageSize)))
+	}
+	return company, nil
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	memoryrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/memory_repo"
+)
+
+// MockCompanyRepo is a manual mock implementation of CompanyRepository
+type MockCompanyRepo struct {
+	GetCompanyByIdFunc     func(ctx context.Context, id string) (model.CompanyResponse, error)
+	FetchCompanyMoviesFunc func(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) ([]model.DiscoverItem, int, error)
+}
+
+func (m *MockCompanyRepo) GetCompanyById(ctx context.Context, id string) (model.CompanyResponse, error) {
+	if m.GetCompanyByIdFunc != nil {
+		return m.GetCompanyByIdFunc(ctx, id)
+	}
+	return model.CompanyResponse{}, nil
+}
+
+func (m *MockCompanyRepo) FetchCompanyMovies(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) ([]model.DiscoverItem, int, error) {
+	if m.FetchCompanyMoviesFunc != nil {
+		return m.FetchCompanyMoviesFunc(ctx, id, lang, includeAdult, page, pageSize)
+	}
+	return nil, 0, nil
+}
+
+func TestGetCompanyById_Success(t *testing.T) {
+	mockRepo := &MockCompanyRepo{
+		GetCompanyByIdFunc: func(ctx context.Context, id string) (model.CompanyResponse, error) {
+			return model.CompanyResponse{Name: "Legendary Pictures"}, nil
+		},
+		FetchCompanyMoviesFunc: func(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) ([]model.DiscoverItem, int, error) {
+			if page != 2 || pageSize != 10 {
+				t.Errorf("expected page 2 of 10, got %d of %d", page, pageSize)
+			}
+			return []model.DiscoverItem{{Title: "Dune"}}, 11, nil
+		},
+	}
+
+	svc := New_Company_Service(mockRepo)
+	result, err := svc.GetCompanyById(context.Background(), "id", "en", false, 2, 10)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Name != "Legendary Pictures" {
+		t.Errorf("expected company name, got %s", result.Name)
+	}
+	if result.Movies == nil || result.Movies.TotalPages != 2 || len(result.Movies.Results) != 1 {
+		t.Errorf("expected one movie on page 2 of 2, got %+v", result.Movies)
+	}
+}
+
+func TestGetCompanyById_Error(t *testing.T) {
+	mockRepo := &MockCompanyRepo{
+		GetCompanyByIdFunc: func(ctx context.Context, id string) (model.CompanyResponse, error) {
+			return model.CompanyResponse{}, errors.New("not found")
+		},
+	}
+
+	svc := New_Company_Service(mockRepo)
+	_, err := svc.GetCompanyById(context.Background(), "id", "en", false, 1, 20)
+
+	if err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func TestGetCompanyById_MemoryRepo(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	svc := New_Company_Service(memoryrepo.New_Memory_Repo(ds))
+
+	company := ds.Companies[0]
+	want := 0
+	for _, m := range ds.Movies {
+		for _, cid := range m.CompanyIDs {
+			if cid == company.ID && !m.Adult {
+				want++
+			}
+		}
+	}
+
+	if want < 2 {
+		t.Fatalf("fixture company %s has too few movies", company.Name)
+	}
+
+	result, err := svc.GetCompanyById(context.Background(), company.ID.String(), "en", false, 1, 100)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Movies.TotalResults != want || len(result.Movies.Results) != want {
+		t.Fatalf("expected %d movies, got %d", want, result.Movies.TotalResults)
+	}
+	for i := 1; i < len(result.Movies.Results); i++ {
+		if *result.Movies.Results[i-1].ReleaseDate < *result.Movies.Results[i].ReleaseDate {
+			t.Errorf("expected newest movies first")
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	languagerepo "github.com/h-raju-arch/movie_app_backend/internal/repo/language_repo"
+)
+
+type Configuration_Service interface {
+	ListLanguages(ctx context.Context) ([]model.Language, error)
+}
+
+type configuration_service struct {
+	repo languagerepo.LanguageRepository
+}
+
+func New_Configuration_Service(r languagerepo.LanguageRepository) *configuration_service {
+	return &configuration_service{repo: r}
+}
+
+// ListLanguages returns every language the catalogue knows about, with its
+// English and native name.
+func (r configuration_service) ListLanguages(ctx context.Context) ([]model.Language, error) {
+	languages, err := r.repo.ListLanguages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service: ListLanguages: %w", err)
+	}
+	return languages, nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	memoryrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/memory_repo"
+)
+
+// MockLanguageRepo is a manual mock implementation of LanguageRepository
+type MockLanguageRepo struct {
+	ListLanguagesFunc func(ctx context.Context) ([]model.Language, error)
+	GetLanguageFunc   func(ctx context.Context, iso string) (model.Language, error)
+}
+
+func (m *MockLanguageRepo) ListLanguages(ctx context.Context) ([]model.Language, error) {
+	if m.ListLanguagesFunc != nil {
+		return m.ListLanguagesFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockLanguageRepo) GetLanguage(ctx context.Context, iso string) (model.Language, error) {
+	if m.GetLanguageFunc != nil {
+		return m.GetLanguageFunc(ctx, iso)
+	}
+	return model.Language{}, nil
+}
+
+func TestListLanguages_Error(t *testing.T) {
+	mockRepo := &MockLanguageRepo{
+		ListLanguagesFunc: func(ctx context.Context) ([]model.Language, error) {
+			return nil, errors.New("database error")
+		},
+	}
+
+	svc := New_Configuration_Service(mockRepo)
+	_, err := svc.ListLanguages(context.Background())
+
+	if err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func TestListLanguages_MemoryRepo(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	svc := New_Configuration_Service(memoryrepo.New_Memory_Repo(ds))
+
+	result, err := svc.ListLanguages(context.Background())
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result) != len(ds.Languages) {
+		t.Fatalf("expected %d languages, got %d", len(ds.Languages), len(result))
+	}
+	for i := 1; i < len(result); i++ {
+		if result[i-1].EnglishName > result[i].EnglishName {
+			t.Errorf("expected languages ordered by English name, got %s before %s", result[i-1].EnglishName, result[i].EnglishName)
+		}
+	}
+	for _, l := range result {
+		if l.ISO6391 == "ko" && l.Name != "한국어" {
+			t.Errorf("expected native name for Korean, got %s", l.Name)
+		}
+	}
+}
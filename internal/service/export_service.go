@@ -0,0 +1,98 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	exportrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/export_repo"
+)
+
+const DefaultExportBatchSize = 500
+
+type Export_Service interface {
+	Export(ctx context.Context, w io.Writer, since *time.Time, batchSize int) (int, error)
+}
+
+type export_service struct {
+	repo exportrepo.ExportRepository
+}
+
+func New_Export_Service(r exportrepo.ExportRepository) *export_service {
+	return &export_service{repo: r}
+}
+
+// Export writes every movie updated at or after since (all of them when
+// since is nil) to w as NDJSON, in id order, one movie per line shaped like
+// GetMovieById with every append.
+// Movies are read batchSize at a time and each batch is written to w in a
+// single Write. It returns the number of movies written; on error that
+// many complete lines have been written.
+func (r export_service) Export(ctx context.Context, w io.Writer, since *time.Time, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultExportBatchSize
+	}
+
+	written := 0
+	after := uuid.Nil
+	var buf bytes.Buffer
+	for {
+		movies, err := r.repo.ListMovies(ctx, since, after, batchSize)
+		if err != nil {
+			return written, fmt.Errorf("service: ListMovies: %w", err)
+		}
+		if len(movies) == 0 {
+			return written, nil
+		}
+
+		ids := make([]uuid.UUID, len(movies))
+		for i, m := range movies {
+			ids[i] = m.ID
+		}
+		appends, err := r.repo.FetchAppends(ctx, ids)
+		if err != nil {
+			return written, fmt.Errorf("service: FetchAppends: %w", err)
+		}
+
+		buf.Reset()
+		enc := json.NewEncoder(&buf)
+		for _, m := range movies {
+			m.Genres = appends.Genres[m.ID]
+			m.ProductionCompanies = appends.Companies[m.ID]
+			m.Credits = splitCredits(appends.Credits[m.ID])
+			m.Images = groupImages(appends.Images[m.ID])
+			m.Videos = appends.Videos[m.ID]
+			m.SpokenLanguages = appends.SpokenLanguages[m.ID]
+			m.Translations = appends.Translations[m.ID]
+			if err := enc.Encode(m); err != nil {
+				return written, fmt.Errorf("service: encode movie %s: %w", m.ID, err)
+			}
+		}
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return written, fmt.Errorf("service: write export: %w", err)
+		}
+		written += len(movies)
+
+		if len(movies) < batchSize {
+			return written, nil
+		}
+		after = movies[len(movies)-1].ID
+	}
+}
+
+// ParseExportSince parses the since filter of an export: an RFC 3339
+// timestamp, or a YYYY-MM-DD date meaning midnight UTC.
+func ParseExportSince(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid since %q: expected an RFC 3339 timestamp or YYYY-MM-DD", s)
+	}
+	return t, nil
+}
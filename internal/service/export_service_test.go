@@ -0,0 +1,177 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	memoryrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/memory_repo"
+)
+
+// MockExportRepo is a manual mock implementation of ExportRepository
+type MockExportRepo struct {
+	ListMoviesFunc   func(ctx context.Context, since *time.Time, after uuid.UUID, limit int) ([]model.MovieResponse, error)
+	FetchAppendsFunc func(ctx context.Context, ids []uuid.UUID) (model.ExportAppends, error)
+}
+
+func (m *MockExportRepo) ListMovies(ctx context.Context, since *time.Time, after uuid.UUID, limit int) ([]model.MovieResponse, error) {
+	if m.ListMoviesFunc != nil {
+		return m.ListMoviesFunc(ctx, since, after, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockExportRepo) FetchAppends(ctx context.Context, ids []uuid.UUID) (model.ExportAppends, error) {
+	if m.FetchAppendsFunc != nil {
+		return m.FetchAppendsFunc(ctx, ids)
+	}
+	return model.ExportAppends{}, nil
+}
+
+func exportLines(t *testing.T, out []byte) []model.MovieResponse {
+	t.Helper()
+	var res []model.MovieResponse
+	sc := bufio.NewScanner(bytes.NewReader(out))
+	sc.Buffer(nil, 1<<20)
+	for sc.Scan() {
+		var m model.MovieResponse
+		if err := json.Unmarshal(sc.Bytes(), &m); err != nil {
+			t.Fatalf("invalid NDJSON line %q: %v", sc.Text(), err)
+		}
+		res = append(res, m)
+	}
+	return res
+}
+
+func TestExport_MemoryRepo(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	ctx := context.Background()
+
+	var out bytes.Buffer
+	n, err := New_Export_Service(mem).Export(ctx, &out, nil, 7)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	lines := exportLines(t, out.Bytes())
+	if n != len(ds.Movies) || len(lines) != n {
+		t.Fatalf("expected %d movies, got %d written and %d lines", len(ds.Movies), n, len(lines))
+	}
+	for i := 1; i < len(lines); i++ {
+		if lines[i-1].ID.String() >= lines[i].ID.String() {
+			t.Fatalf("expected movies in id order, got %s before %s", lines[i-1].ID, lines[i].ID)
+		}
+	}
+
+	// every line has what GetMovieById returns with all appends
+	for _, line := range lines {
+		if line.Title != "Inception" {
+			continue
+		}
+		want, err := New_Movie_Service(mem).GetMovieById(ctx, line.ID.String(), "en",
+			[]string{"genres", "companies", "credits", "images", "spoken_languages", "translations"}, model.AppendOptions{})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		got, _ := json.Marshal(line)
+		want.Videos = line.Videos // GetMovieById only appends videos in the request language
+		wantJSON, _ := json.Marshal(want)
+		if !bytes.Equal(got, wantJSON) {
+			t.Errorf("expected the GetMovieById structure\n got %s\nwant %s", got, wantJSON)
+		}
+		if len(line.Videos) <= 1 || len(line.Translations) != 3 {
+			t.Errorf("expected every video and translation, got %d and %d", len(line.Videos), len(line.Translations))
+		}
+		return
+	}
+	t.Fatal("Inception not exported")
+}
+
+func TestExport_Since(t *testing.T) {
+	ds := fixtures.Build(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	mem := memoryrepo.New_Memory_Repo(ds)
+	ctx := context.Background()
+	since := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := mem.PutTranslation(ctx, ds.Movies[3].ID.String(), "de", model.TranslationWrite{Title: ptr("Titel")}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var out bytes.Buffer
+	n, err := New_Export_Service(mem).Export(ctx, &out, &since, 0)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	lines := exportLines(t, out.Bytes())
+	if n != 1 || len(lines) != 1 || lines[0].ID != ds.Movies[3].ID {
+		t.Fatalf("expected only the updated movie, got %d", n)
+	}
+	if lines[0].UpdatedAt.Before(since) {
+		t.Errorf("expected updated_at after %s, got %s", since, lines[0].UpdatedAt)
+	}
+}
+
+func TestExport_BatchesAndErrors(t *testing.T) {
+	var ids []uuid.UUID
+	for range 5 {
+		ids = append(ids, uuid.Must(uuid.NewV7()))
+	}
+	slices.SortFunc(ids, func(a, b uuid.UUID) int { return strings.Compare(a.String(), b.String()) })
+	var afters []uuid.UUID
+	mockRepo := &MockExportRepo{
+		ListMoviesFunc: func(ctx context.Context, since *time.Time, after uuid.UUID, limit int) ([]model.MovieResponse, error) {
+			afters = append(afters, after)
+			if len(afters) == 3 {
+				return nil, errors.New("database error")
+			}
+			var res []model.MovieResponse
+			for _, id := range ids {
+				if id.String() > after.String() && len(res) < limit {
+					res = append(res, model.MovieResponse{ID: id, Title: "Movie"})
+				}
+			}
+			return res, nil
+		},
+	}
+
+	var out bytes.Buffer
+	n, err := New_Export_Service(mockRepo).Export(context.Background(), &out, nil, 2)
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	// the batches before the error are complete lines
+	if n != 4 || strings.Count(out.String(), "\n") != 4 {
+		t.Errorf("expected 4 movies written, got %d and %q", n, out.String())
+	}
+	if afters[0] != uuid.Nil || afters[1] != ids[1] || afters[2] != ids[3] {
+		t.Errorf("expected keyset pagination by id, got %v", afters)
+	}
+}
+
+func TestParseExportSince(t *testing.T) {
+	for in, want := range map[string]time.Time{
+		"2026-03-01":                time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		"2026-03-01T10:30:00Z":      time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC),
+		"2026-03-01T10:30:00+02:00": time.Date(2026, 3, 1, 8, 30, 0, 0, time.UTC),
+	} {
+		got, err := ParseExportSince(in)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("%s: expected %s, got %s, %v", in, want, got, err)
+		}
+	}
+	if _, err := ParseExportSince("yesterday"); err == nil {
+		t.Error("expected error for an invalid since")
+	}
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	genrerepo "github.com/h-raju-arch/movie_app_backend/internal/repo/genre_repo"
+)
+
+type Genre_Service interface {
+	ListMovieGenres(ctx context.Context, lang string) (model.GenreListResponse, error)
+}
+
+type genre_service struct {
+	repo genrerepo.GenreRepository
+}
+
+func New_Genre_Service(r genrerepo.GenreRepository) *genre_service {
+	return &genre_service{repo: r}
+}
+
+// ListMovieGenres returns every genre with its name in lang, or in English
+// where there is no translation.
+func (r genre_service) ListMovieGenres(ctx context.Context, lang string) (model.GenreListResponse, error) {
+	genres, err := r.repo.ListGenres(ctx, lang)
+	if err != nil {
+		return model.GenreListResponse{}, fmt.Errorf("service: ListGenres: %w", err)
+	}
+	return model.GenreListResponse{Genres: genres}, nil
+}
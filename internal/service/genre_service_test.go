@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	memoryrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/memory_repo"
+)
+
+// MockGenreRepo is a manual mock implementation of GenreRepository
+type MockGenreRepo struct {
+	ListGenresFunc func(ctx context.Context, lang string) ([]model.Genre, error)
+}
+
+func (m *MockGenreRepo) ListGenres(ctx context.Context, lang string) ([]model.Genre, error) {
+	if m.ListGenresFunc != nil {
+		return m.ListGenresFunc(ctx, lang)
+	}
+	return nil, nil
+}
+
+func TestListMovieGenres_Error(t *testing.T) {
+	mockRepo := &MockGenreRepo{
+		ListGenresFunc: func(ctx context.Context, lang string) ([]model.Genre, error) {
+			return nil, errors.New("database error")
+		},
+	}
+
+	svc := New_Genre_Service(mockRepo)
+	_, err := svc.ListMovieGenres(context.Background(), "en")
+
+	if err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func TestListMovieGenres_MemoryRepo(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	svc := New_Genre_Service(memoryrepo.New_Memory_Repo(ds))
+
+	result, err := svc.ListMovieGenres(context.Background(), "es")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result.Genres) != len(ds.Genres) {
+		t.Fatalf("expected %d genres, got %d", len(ds.Genres), len(result.Genres))
+	}
+
+	names := make(map[string]bool)
+	for _, g := range result.Genres {
+		names[g.Name] = true
+	}
+	if !names["Ciencia ficción"] {
+		t.Errorf("expected localized name, got %v", result.Genres)
+	}
+	// War has no Spanish translation
+	if !names["War"] {
+		t.Errorf("expected English fallback, got %v", result.Genres)
+	}
+}
+
+func TestGetMovieById_LocalizedGenres(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	svc := New_Movie_Service(memoryrepo.New_Memory_Repo(ds))
+
+	result, err := svc.GetMovieById(context.Background(), ds.Movies[0].ID.String(), "fr", []string{"genres"}, model.AppendOptions{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result.Genres) == 0 {
+		t.Fatal("expected genres")
+	}
+	for _, g := range result.Genres {
+		if g.ID.IsNil() || g.Name == "" {
+			t.Errorf("expected id and name, got %+v", g)
+		}
+	}
+}
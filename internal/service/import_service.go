@@ -0,0 +1,195 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	importrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/import_repo"
+	languagerepo "github.com/h-raju-arch/movie_app_backend/internal/repo/language_repo"
+)
+
+const (
+	DefaultImportBatchSize = 500
+
+	// maxImportLine bounds a single NDJSON line, i.e. one movie with all
+	// its credits, images and translations.
+	maxImportLine = 16 << 20
+
+	// maxImportErrors is how many skipped lines the summary describes; the
+	// rest are only counted.
+	maxImportErrors = 100
+)
+
+type Import_Service interface {
+	Import(ctx context.Context, r io.Reader, batchSize int) (model.ImportSummary, error)
+}
+
+type import_service struct {
+	repo      importrepo.ImportRepository
+	languages languagerepo.LanguageRepository
+}
+
+func New_Import_Service(r importrepo.ImportRepository, l languagerepo.LanguageRepository) *import_service {
+	return &import_service{repo: r, languages: l}
+}
+
+// Import reads one model.ImportMovie per line from r and upserts them,
+// committing every batchSize movies. Lines that aren't valid, or whose
+// external_id a later line of the same batch repeats, are skipped and
+// described in the summary. It stops at the first failing batch; the
+// summary then counts the batches committed before it.
+func (r import_service) Import(ctx context.Context, in io.Reader, batchSize int) (model.ImportSummary, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultImportBatchSize
+	}
+
+	var summary model.ImportSummary
+	languages, err := r.languages.ListLanguages(ctx)
+	if err != nil {
+		return summary, fmt.Errorf("service: ListLanguages: %w", err)
+	}
+	known := make(map[string]bool, len(languages))
+	for _, l := range languages {
+		known[l.ISO6391] = true
+	}
+
+	skip := func(line int, externalID, message string) {
+		summary.Skipped++
+		if len(summary.Errors) < maxImportErrors {
+			summary.Errors = append(summary.Errors, model.ImportError{Line: line, ExternalID: externalID, Message: message})
+		}
+	}
+
+	// a movie listed twice in a batch is only upserted once, from its last
+	// line; the earlier one is reported as skipped
+	batch := make([]model.ImportMovie, 0, batchSize)
+	batchLines := make([]int, 0, batchSize)
+	inBatch := make(map[string]int)
+	flush := func(line int) error {
+		if len(batch) == 0 {
+			return nil
+		}
+		inserted, err := r.repo.UpsertMovies(ctx, batch)
+		if err != nil {
+			return fmt.Errorf("service: import batch ending at line %d: %w", line, err)
+		}
+		for _, ins := range inserted {
+			if ins {
+				summary.Inserted++
+			} else {
+				summary.Updated++
+			}
+		}
+		batch, batchLines = batch[:0], batchLines[:0]
+		clear(inBatch)
+		return nil
+	}
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxImportLine)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		var m model.ImportMovie
+		if err := json.Unmarshal(text, &m); err != nil {
+			skip(line, "", "invalid JSON: "+err.Error())
+			continue
+		}
+		if err := validateImportMovie(m, known); err != nil {
+			skip(line, m.ExternalID, err.Error())
+			continue
+		}
+
+		if i, ok := inBatch[m.ExternalID]; ok {
+			skip(batchLines[i], m.ExternalID, fmt.Sprintf("replaced by line %d with the same external_id", line))
+			batch[i], batchLines[i] = m, line
+			continue
+		}
+		inBatch[m.ExternalID] = len(batch)
+		batch = append(batch, m)
+		batchLines = append(batchLines, line)
+		if len(batch) == batchSize {
+			if err := flush(line); err != nil {
+				return summary, err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			err = fmt.Errorf("line %d is longer than %d bytes", line+1, maxImportLine)
+		}
+		return summary, fmt.Errorf("service: read import: %w", err)
+	}
+	return summary, flush(line)
+}
+
+var (
+	importCreditTypes = map[string]bool{"cast": true, "crew": true}
+	importImageTypes  = map[string]bool{"poster": true, "backdrop": true, "still": true}
+)
+
+// validateImportMovie checks what the store would reject, so one bad line
+// can't fail the whole batch it is in.
+func validateImportMovie(m model.ImportMovie, languages map[string]bool) error {
+	switch {
+	case strings.TrimSpace(m.ExternalID) == "":
+		return errors.New("external_id is required")
+	case strings.TrimSpace(m.Title) == "":
+		return errors.New("title is required")
+	}
+	if m.OriginalLanguage != nil && len(*m.OriginalLanguage) > 10 {
+		return fmt.Errorf("original_language %q must be a code of at most 10 characters", *m.OriginalLanguage)
+	}
+	if m.ReleaseDate != nil {
+		if _, err := time.Parse(time.DateOnly, *m.ReleaseDate); err != nil {
+			return fmt.Errorf("release_date %q is not a YYYY-MM-DD date", *m.ReleaseDate)
+		}
+	}
+	for _, name := range append(append([]string(nil), m.Genres...), m.Companies...) {
+		if strings.TrimSpace(name) == "" {
+			return errors.New("genre and company names must not be blank")
+		}
+	}
+	for _, iso := range m.SpokenLanguages {
+		if !languages[iso] {
+			return fmt.Errorf("spoken language %q is not a known language", iso)
+		}
+	}
+	for _, c := range m.Credits {
+		if strings.TrimSpace(c.Name) == "" {
+			return errors.New("credit name must not be blank")
+		}
+		if !importCreditTypes[c.CreditType] {
+			return fmt.Errorf("credit_type %q must be cast or crew", c.CreditType)
+		}
+	}
+	for _, img := range m.Images {
+		if !importImageTypes[img.Type] {
+			return fmt.Errorf("image type %q must be poster, backdrop or still", img.Type)
+		}
+	}
+	seen := make(map[string]bool)
+	for _, t := range m.Translations {
+		if t.Language == "" || len(t.Language) > 10 {
+			return fmt.Errorf("translation language %q must be a code of at most 10 characters", t.Language)
+		}
+		if seen[t.Language] {
+			return fmt.Errorf("translation language %q is listed twice", t.Language)
+		}
+		seen[t.Language] = true
+	}
+	return nil
+}
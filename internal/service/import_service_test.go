@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	memoryrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/memory_repo"
+)
+
+// MockImportRepo is a manual mock implementation of ImportRepository
+type MockImportRepo struct {
+	UpsertMoviesFunc func(ctx context.Context, movies []model.ImportMovie) ([]bool, error)
+}
+
+func (m *MockImportRepo) UpsertMovies(ctx context.Context, movies []model.ImportMovie) ([]bool, error) {
+	if m.UpsertMoviesFunc != nil {
+		return m.UpsertMoviesFunc(ctx, movies)
+	}
+	return make([]bool, len(movies)), nil
+}
+
+const importFile = `{"external_id": "tt1", "title": "First", "release_date": "2024-01-02", "genres": ["Action", "Brand New Genre"], "companies": ["Warner Bros. Pictures"], "spoken_languages": ["en"], "credits": [{"name": "Christopher Nolan", "credit_type": "crew", "department": "Directing", "job": "Director"}, {"name": "Newcomer", "credit_type": "cast", "character": "Lead", "cast_order": 1}], "images": [{"type": "poster", "file_path": "/first.jpg", "width": 500, "height": 750}], "translations": [{"language": "es", "title": "Primera"}]}
+
+not json
+{"external_id": "tt2", "title": ""}
+{"external_id": "tt3", "title": "Third", "spoken_languages": ["xx"]}
+{"external_id": "tt4", "title": "Fourth", "credits": [{"name": "Someone", "credit_type": "guest"}]}
+{"external_id": "tt5", "title": "Fifth", "release_date": "soon"}
+`
+
+func TestImport_MemoryRepo(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	svc := New_Import_Service(mem, mem)
+	ctx := context.Background()
+
+	summary, err := svc.Import(ctx, strings.NewReader(importFile), 2)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if summary.Inserted != 1 || summary.Updated != 0 || summary.Skipped != 5 {
+		t.Fatalf("expected 1 inserted and 5 skipped, got %+v", summary)
+	}
+	if summary.Errors[0].Line != 3 || summary.Errors[1].ExternalID != "tt2" {
+		t.Errorf("expected skipped lines to be located, got %+v", summary.Errors)
+	}
+
+	found, err := New_Movie_Service(mem).SearchMovie(ctx, "Primera", "es", false, sql.NullInt64{}, sql.NullString{}, 1, 20, false, "")
+	if err != nil || len(found.Results) != 1 {
+		t.Fatalf("expected the imported translation to be searchable, got %+v, %v", found.Results, err)
+	}
+	movie, err := New_Movie_Service(mem).GetMovieById(ctx, found.Results[0].ID.String(), "en", []string{"genres", "companies", "credits"}, model.AppendOptions{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(movie.Genres) != 2 || len(movie.ProductionCompanies) != 1 {
+		t.Errorf("expected genres and companies resolved by name, got %v and %v", movie.Genres, movie.ProductionCompanies)
+	}
+	for _, c := range movie.ProductionCompanies {
+		if c.OriginCountry == nil {
+			t.Errorf("expected the existing company to be reused, got %+v", c)
+		}
+	}
+	if len(movie.Credits.Cast) != 1 || len(movie.Credits.Crew) != 1 || movie.Credits.Crew[0].ProfilePath == nil {
+		t.Errorf("expected the existing director and a new cast member, got %+v", movie.Credits)
+	}
+
+	// the same external id again is an update, which keeps the votes
+	votes := func() (float64, int) {
+		m, _ := New_Movie_Service(mem).GetMovieById(ctx, movie.ID.String(), "en", nil, model.AppendOptions{})
+		return *m.VoteAverage, *m.VoteCount
+	}
+	voteAverage, voteCount := votes()
+	summary, err = svc.Import(ctx, strings.NewReader(`{"external_id": "tt1", "title": "First, revised", "vote_average": 9.9, "vote_count": 999}`), 0)
+	if err != nil || summary.Updated != 1 || summary.Inserted != 0 {
+		t.Fatalf("expected 1 update, got %+v, %v", summary, err)
+	}
+	movie, _ = New_Movie_Service(mem).GetMovieById(ctx, movie.ID.String(), "en", []string{"genres"}, model.AppendOptions{})
+	if movie.Title != "First, revised" || len(movie.Genres) != 0 {
+		t.Errorf("expected the movie to be replaced, got %s with %v", movie.Title, movie.Genres)
+	}
+	if a, c := votes(); a != voteAverage || c != voteCount {
+		t.Errorf("expected the votes kept at %v / %d, got %v / %d", voteAverage, voteCount, a, c)
+	}
+}
+
+func TestImport_Batches(t *testing.T) {
+	var sizes []int
+	mockRepo := &MockImportRepo{
+		UpsertMoviesFunc: func(ctx context.Context, movies []model.ImportMovie) ([]bool, error) {
+			sizes = append(sizes, len(movies))
+			return make([]bool, len(movies)), nil
+		},
+	}
+	svc := New_Import_Service(mockRepo, &MockLanguageRepo{})
+
+	var lines []string
+	for i := range 5 {
+		lines = append(lines, fmt.Sprintf(`{"external_id": "tt%d", "title": "Movie %d"}`, i, i))
+	}
+	summary, err := svc.Import(context.Background(), strings.NewReader(strings.Join(lines, "\n")), 2)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if fmt.Sprint(sizes) != "[2 2 1]" {
+		t.Errorf("expected batches of 2, 2 and 1, got %v", sizes)
+	}
+	if summary.Updated != 5 {
+		t.Errorf("expected 5 updates, got %+v", summary)
+	}
+}
+
+func TestImport_DuplicatesInBatch(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	svc := New_Import_Service(mem, mem)
+	ctx := context.Background()
+
+	in := `{"external_id": "tt1", "title": "First draft"}
+{"external_id": "tt2", "title": "Second"}
+{"external_id": "tt1", "title": "Final cut"}
+`
+	summary, err := svc.Import(ctx, strings.NewReader(in), 10)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if summary.Inserted != 2 || summary.Updated != 0 || summary.Skipped != 1 {
+		t.Fatalf("expected 2 inserted and the repeated line skipped, got %+v", summary)
+	}
+	if e := summary.Errors[0]; e.Line != 1 || e.ExternalID != "tt1" {
+		t.Errorf("expected the first copy reported, got %+v", e)
+	}
+	found, _ := New_Movie_Service(mem).SearchMovie(ctx, "Final cut", "en", false, sql.NullInt64{}, sql.NullString{}, 1, 20, false, "")
+	if len(found.Results) != 1 {
+		t.Errorf("expected the last copy imported, got %+v", found.Results)
+	}
+}
+
+func TestImport_BatchError(t *testing.T) {
+	calls := 0
+	mockRepo := &MockImportRepo{
+		UpsertMoviesFunc: func(ctx context.Context, movies []model.ImportMovie) ([]bool, error) {
+			calls++
+			if calls == 2 {
+				return nil, errors.New("database error")
+			}
+			return []bool{true}, nil
+		},
+	}
+	svc := New_Import_Service(mockRepo, &MockLanguageRepo{})
+
+	in := "{\"external_id\": \"a\", \"title\": \"A\"}\n{\"external_id\": \"b\", \"title\": \"B\"}\n{\"external_id\": \"c\", \"title\": \"C\"}\n"
+	summary, err := svc.Import(context.Background(), strings.NewReader(in), 1)
+
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("expected an error naming line 2, got %v", err)
+	}
+	if calls != 2 || summary.Inserted != 1 {
+		t.Errorf("expected the import to stop after the failed batch, got %d calls and %+v", calls, summary)
+	}
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// invalidate evicts what a successful write to the movie changed: its own
+// cached responses and, with lists set, every cached list. Failures are
+// logged rather than returned, since the write itself went through; the
+// stale entries then live out their TTL.
+func invalidate(ctx context.Context, inv Cache_Invalidator, movieID string, lists bool) {
+	if movieID != "" {
+		if err := inv.InvalidateMovie(ctx, movieID); err != nil {
+			log.Println("cache:", err)
+		}
+	}
+	if lists {
+		if err := inv.InvalidateLists(ctx); err != nil {
+			log.Println("cache:", err)
+		}
+	}
+}
+
+// invalidating_admin_service evicts the cached responses an admin write
+// changed. Movies and their translations show up in search, discover and
+// similar pages too, so every write evicts the lists.
+type invalidating_admin_service struct {
+	Admin_Service
+	inv Cache_Invalidator
+}
+
+func New_Invalidating_Admin_Service(next Admin_Service, inv Cache_Invalidator) *invalidating_admin_service {
+	return &invalidating_admin_service{Admin_Service: next, inv: inv}
+}
+
+func (r *invalidating_admin_service) CreateMovie(ctx context.Context, w model.MovieWrite) (model.MovieResponse, error) {
+	res, err := r.Admin_Service.CreateMovie(ctx, w)
+	if err == nil {
+		invalidate(ctx, r.inv, "", true)
+	}
+	return res, err
+}
+
+func (r *invalidating_admin_service) ReplaceMovie(ctx context.Context, id string, w model.MovieWrite) (model.MovieResponse, error) {
+	res, err := r.Admin_Service.ReplaceMovie(ctx, id, w)
+	if err == nil {
+		invalidate(ctx, r.inv, id, true)
+	}
+	return res, err
+}
+
+func (r *invalidating_admin_service) PatchMovie(ctx context.Context, id string, w model.MovieWrite) (model.MovieResponse, error) {
+	res, err := r.Admin_Service.PatchMovie(ctx, id, w)
+	if err == nil {
+		invalidate(ctx, r.inv, id, true)
+	}
+	return res, err
+}
+
+func (r *invalidating_admin_service) DeleteMovie(ctx context.Context, id string) error {
+	err := r.Admin_Service.DeleteMovie(ctx, id)
+	if err == nil {
+		invalidate(ctx, r.inv, id, true)
+	}
+	return err
+}
+
+func (r *invalidating_admin_service) PutTranslation(ctx context.Context, id, lang string, t model.TranslationWrite) (model.MovieTranslation, bool, error) {
+	res, created, err := r.Admin_Service.PutTranslation(ctx, id, lang, t)
+	if err == nil {
+		invalidate(ctx, r.inv, id, true)
+	}
+	return res, created, err
+}
+
+func (r *invalidating_admin_service) DeleteTranslation(ctx context.Context, id, lang string) error {
+	err := r.Admin_Service.DeleteTranslation(ctx, id, lang)
+	if err == nil {
+		invalidate(ctx, r.inv, id, true)
+	}
+	return err
+}
+
+// invalidating_rating_service evicts the cached responses a vote changed:
+// the movie's vote_average and vote_count are shown, filtered and sorted on
+// in lists too.
+type invalidating_rating_service struct {
+	Rating_Service
+	inv Cache_Invalidator
+}
+
+func New_Invalidating_Rating_Service(next Rating_Service, inv Cache_Invalidator) *invalidating_rating_service {
+	return &invalidating_rating_service{Rating_Service: next, inv: inv}
+}
+
+func (r *invalidating_rating_service) RateMovie(ctx context.Context, userID uuid.UUID, movieID string, w model.RatingWrite) (model.MovieRating, bool, error) {
+	res, created, err := r.Rating_Service.RateMovie(ctx, userID, movieID, w)
+	if err == nil {
+		invalidate(ctx, r.inv, movieID, true)
+	}
+	return res, created, err
+}
+
+func (r *invalidating_rating_service) DeleteRating(ctx context.Context, userID uuid.UUID, movieID string) (model.MovieRating, error) {
+	res, err := r.Rating_Service.DeleteRating(ctx, userID, movieID)
+	if err == nil {
+		invalidate(ctx, r.inv, movieID, true)
+	}
+	return res, err
+}
+
+// invalidating_review_service evicts the movies whose public reviews a
+// write may have changed. Reviews only appear in the movie's own responses
+// (the reviews append), so lists are kept.
+type invalidating_review_service struct {
+	Review_Service
+	inv Cache_Invalidator
+}
+
+func New_Invalidating_Review_Service(next Review_Service, inv Cache_Invalidator) *invalidating_review_service {
+	return &invalidating_review_service{Review_Service: next, inv: inv}
+}
+
+// WriteReview evicts the movie since replacing an approved review hides it
+// until it is moderated again.
+func (r *invalidating_review_service) WriteReview(ctx context.Context, authorID uuid.UUID, movieID string, w model.ReviewWrite) (model.Review, bool, error) {
+	res, created, err := r.Review_Service.WriteReview(ctx, authorID, movieID, w)
+	if err == nil {
+		invalidate(ctx, r.inv, movieID, false)
+	}
+	return res, created, err
+}
+
+func (r *invalidating_review_service) SetReviewStatus(ctx context.Context, id, status string) (model.Review, error) {
+	res, err := r.Review_Service.SetReviewStatus(ctx, id, status)
+	if err == nil {
+		invalidate(ctx, r.inv, res.MovieID.String(), false)
+	}
+	return res, err
+}
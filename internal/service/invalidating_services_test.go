@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/cache"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	memoryrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/memory_repo"
+)
+
+// recordingInvalidator records the invalidations asked of it.
+type recordingInvalidator struct {
+	movies []string
+	lists  int
+}
+
+func (r *recordingInvalidator) InvalidateMovie(ctx context.Context, id string) error {
+	r.movies = append(r.movies, id)
+	return nil
+}
+
+func (r *recordingInvalidator) InvalidateLists(ctx context.Context) error {
+	r.lists++
+	return nil
+}
+
+func (r *recordingInvalidator) InvalidateAll(ctx context.Context) error {
+	return nil
+}
+
+func TestInvalidatingServices_WritesShowUpImmediately(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	movies := New_Cached_Movie_Service(New_Movie_Service(mem), cache.NewMemory(100), DefaultCacheTTLs)
+	admin := New_Invalidating_Admin_Service(New_Admin_Service(mem, mem, mem), movies)
+	ratings := New_Invalidating_Rating_Service(New_Rating_Service(mem), movies)
+	reviews := New_Invalidating_Review_Service(New_Review_Service(mem, mem), movies)
+	ctx := context.Background()
+	id := ds.Movies[0].ID.String()
+	user := uuid.Must(uuid.NewV7())
+
+	get := func(lang string) model.MovieResponse {
+		t.Helper()
+		res, err := movies.GetMovieById(ctx, id, lang, []string{"reviews"}, model.AppendOptions{})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		return res
+	}
+	discovered := func() model.DiscoverItem {
+		t.Helper()
+		res, _ := movies.Discover(ctx, model.DiscoverMoviesParams{PageSize: 100})
+		for _, it := range res.Results {
+			if it.ID.String() == id {
+				return it
+			}
+		}
+		t.Fatalf("movie %s not discovered", id)
+		return model.DiscoverItem{}
+	}
+	get("en")
+	get("de")
+	discovered()
+
+	if _, err := admin.PatchMovie(ctx, id, model.MovieWrite{Title: ptr("Renamed")}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := get("en").Title; got != "Renamed" {
+		t.Errorf("expected the new title, got %q", got)
+	}
+	if got := discovered().Title; got != "Renamed" {
+		t.Errorf("expected the new title in discover, got %q", got)
+	}
+
+	if _, _, err := admin.PutTranslation(ctx, id, "de", model.TranslationWrite{Title: ptr("Umbenannt")}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := get("de").Title; got != "Umbenannt" {
+		t.Errorf("expected the new translation, got %q", got)
+	}
+
+	vote, _, err := ratings.RateMovie(ctx, user, id, model.RatingWrite{Value: 10})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := *get("en").VoteCount; got != vote.VoteCount {
+		t.Errorf("expected vote_count %d, got %d", vote.VoteCount, got)
+	}
+	if got := *discovered().VoteCount; got != vote.VoteCount {
+		t.Errorf("expected vote_count %d in discover, got %d", vote.VoteCount, got)
+	}
+
+	rv, _, err := reviews.WriteReview(ctx, user, id, model.ReviewWrite{Content: "Still holds up."})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	reviews.SetReviewStatus(ctx, rv.ID.String(), model.ReviewApproved)
+	if got := get("en").Reviews; got == nil || got.TotalResults != 1 {
+		t.Errorf("expected the approved review, got %+v", got)
+	}
+}
+
+func TestInvalidatingServices_FailedWrites(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	inv := &recordingInvalidator{}
+	admin := New_Invalidating_Admin_Service(New_Admin_Service(mem, mem, mem), inv)
+	reviews := New_Invalidating_Review_Service(New_Review_Service(mem, mem), inv)
+	ctx := context.Background()
+	id := ds.Movies[0].ID.String()
+
+	if _, err := admin.PatchMovie(ctx, id, model.MovieWrite{Popularity: ptr(-1.0)}); err == nil {
+		t.Fatal("expected a validation error")
+	}
+	if err := admin.DeleteMovie(ctx, uuid.Must(uuid.NewV7()).String()); err == nil {
+		t.Fatal("expected an error deleting an unknown movie")
+	}
+	if len(inv.movies) != 0 || inv.lists != 0 {
+		t.Errorf("expected nothing invalidated, got %v and %d", inv.movies, inv.lists)
+	}
+
+	// reviews only change the movie's own responses
+	reviews.WriteReview(ctx, uuid.Must(uuid.NewV7()), id, model.ReviewWrite{Content: "Fine."})
+	if len(inv.movies) != 1 || inv.movies[0] != id || inv.lists != 0 {
+		t.Errorf("expected only the movie invalidated, got %v and %d", inv.movies, inv.lists)
+	}
+}
@@ -5,16 +5,22 @@ import (
 	"database/sql"
 	"fmt"
 	"math"
+	"slices"
+	"strconv"
 	"sync"
 
+	"github.com/h-raju-arch/movie_app_backend/internal/auth"
 	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/h-raju-arch/movie_app_backend/internal/pagination"
 	movierepo "github.com/h-raju-arch/movie_app_backend/internal/repo/movie_repo"
 )
 
 type Movie_Service interface {
-	GetMovieById(ctx context.Context, id, lang string, appendtoresponse []string) (model.MovieResponse, error)
-	SearchMovie(ctx context.Context, searchQuery string, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int) (model.SearchResponse, error)
+	GetMovieById(ctx context.Context, id, lang string, appendtoresponse []string, opts model.AppendOptions) (model.MovieResponse, error)
+	SearchMovie(ctx context.Context, searchQuery string, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int, fuzzy bool, cursor string) (model.SearchResponse, error)
 	Discover(ctx context.Context, params model.DiscoverMoviesParams) (model.DiscoverMoviesResponse, error)
+	GetTranslations(ctx context.Context, id string) (model.TranslationsResponse, error)
+	GetSimilar(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) (model.DiscoverMoviesResponse, error)
 }
 
 type movie_service struct {
@@ -27,23 +33,37 @@ func New_Movie_Service(r movierepo.MovieRepository) *movie_service {
 
 //getMoviebyId
 
-func (r movie_service) GetMovieById(ctx context.Context, id, lang string, appendtoresponse []string) (model.MovieResponse, error) {
+func (r movie_service) GetMovieById(ctx context.Context, id, lang string, appendtoresponse []string, opts model.AppendOptions) (model.MovieResponse, error) {
 	movie, err := r.repo.GetMovieBasebyId(ctx, id, lang)
 
 	if err != nil {
 		return model.MovieResponse{}, fmt.Errorf("service: Get base movie: %w", err)
 	}
 
-	var genres []string
-	var companies []string
-	var credits []model.Credits_Response
+	var genres []model.Genre
+	var companies []model.ProductionCompany
+	var credits []model.MovieCredit
+	var images []model.Image
+	var videos []model.Video
+	var spokenLanguages []model.Language
+	var translations []model.MovieTranslation
+	var reviews *model.ReviewsResponse
+	var similar *model.DiscoverMoviesResponse
+	var accountStates *model.AccountStates
 	var wg sync.WaitGroup
 
 	type result struct {
 		typ       string
-		genres    []string
-		companies []string
-		credits   []model.Credits_Response
+		genres    []model.Genre
+		companies []model.ProductionCompany
+		credits   []model.MovieCredit
+		images    []model.Image
+		videos    []model.Video
+		spoken    []model.Language
+		trans     []model.MovieTranslation
+		reviews   *model.ReviewsResponse
+		similar   *model.DiscoverMoviesResponse
+		states    *model.AccountStates
 		err       error
 	}
 
@@ -60,7 +80,7 @@ func (r movie_service) GetMovieById(ctx context.Context, id, lang string, append
 			defer wg.Done()
 			switch typ {
 			case "genres":
-				g, e := r.repo.FetchGenres(ctx, id)
+				g, e := r.repo.FetchGenres(ctx, id, lang)
 				resultCh <- result{genres: g, typ: typ, err: e}
 				if e != nil {
 					cancel()
@@ -78,6 +98,73 @@ func (r movie_service) GetMovieById(ctx context.Context, id, lang string, append
 				cr, e := r.repo.FetchCredits(ctx, id)
 				resultCh <- result{credits: cr, typ: typ, err: e}
 
+				if e != nil {
+					cancel()
+				}
+
+			case "images":
+				im, e := r.repo.FetchImages(ctx, id, opts.ImageLanguages)
+				resultCh <- result{images: im, typ: typ, err: e}
+
+				if e != nil {
+					cancel()
+				}
+
+			case "videos":
+				// videos in the request language, plus any asked for explicitly
+				filter := opts.VideoLanguages
+				filter.Languages = slices.Concat([]string{lang}, filter.Languages)
+				v, e := r.repo.FetchVideos(ctx, id, filter)
+				resultCh <- result{videos: v, typ: typ, err: e}
+
+				if e != nil {
+					cancel()
+				}
+
+			case "spoken_languages":
+				sl, e := r.repo.FetchSpokenLanguages(ctx, id)
+				resultCh <- result{spoken: sl, typ: typ, err: e}
+
+				if e != nil {
+					cancel()
+				}
+
+			case "translations":
+				t, e := r.repo.FetchTranslations(ctx, id)
+				resultCh <- result{trans: t, typ: typ, err: e}
+
+				if e != nil {
+					cancel()
+				}
+
+			case "reviews":
+				// the first page, with the caller's own review if they wrote one
+				viewer, _ := auth.UserID(ctx)
+				rv, e := fetchReviews(ctx, r.repo, id, viewer, 1, 20)
+				resultCh <- result{reviews: &rv, typ: typ, err: e}
+
+				if e != nil {
+					cancel()
+				}
+
+			case "similar":
+				sm, e := r.fetchSimilar(ctx, id, lang, opts.IncludeAdult, 1, 20)
+				resultCh <- result{similar: &sm, typ: typ, err: e}
+
+				if e != nil {
+					cancel()
+				}
+
+			case "account_states":
+				// only for requests made on behalf of a user
+				userID, ok := auth.UserID(ctx)
+				if !ok {
+					resultCh <- result{typ: typ}
+					return
+				}
+				st, e := r.repo.FetchAccountStates(ctx, id, userID)
+				resultCh <- result{states: &st, typ: typ, err: e}
+
 				if e != nil {
 					cancel()
 				}
@@ -106,6 +193,27 @@ func (r movie_service) GetMovieById(ctx context.Context, id, lang string, append
 		if itr.typ == "credits" {
 			credits = itr.credits
 		}
+		if itr.typ == "images" {
+			images = itr.images
+		}
+		if itr.typ == "videos" {
+			videos = itr.videos
+		}
+		if itr.typ == "spoken_languages" {
+			spokenLanguages = itr.spoken
+		}
+		if itr.typ == "translations" {
+			translations = itr.trans
+		}
+		if itr.typ == "reviews" {
+			reviews = itr.reviews
+		}
+		if itr.typ == "similar" {
+			similar = itr.similar
+		}
+		if itr.typ == "account_states" {
+			accountStates = itr.states
+		}
 	}
 
 	res := model.MovieResponse{
@@ -120,6 +228,7 @@ func (r movie_service) GetMovieById(ctx context.Context, id, lang string, append
 		Budget:       movie.Budget,
 		Revenue:      movie.Revenue,
 		Homepage:     movie.Homepage,
+		UpdatedAt:    movie.UpdatedAt,
 	}
 
 	if contains(appendtoresponse, "genres") {
@@ -129,11 +238,118 @@ func (r movie_service) GetMovieById(ctx context.Context, id, lang string, append
 		res.ProductionCompanies = companies
 	}
 	if contains(appendtoresponse, "credits") {
-		res.Credits = credits
+		res.Credits = splitCredits(credits)
+	}
+	if contains(appendtoresponse, "images") {
+		res.Images = groupImages(images)
+	}
+	if contains(appendtoresponse, "videos") {
+		res.Videos = videos
+	}
+	if contains(appendtoresponse, "spoken_languages") {
+		res.SpokenLanguages = spokenLanguages
+	}
+	if contains(appendtoresponse, "translations") {
+		res.Translations = translations
+	}
+	if contains(appendtoresponse, "reviews") {
+		res.Reviews = reviews
+	}
+	if contains(appendtoresponse, "similar") {
+		res.Similar = similar
+	}
+	if contains(appendtoresponse, "account_states") {
+		res.AccountStates = accountStates
+	}
+	return res, nil
+}
+
+// GetTranslations lists every translation of a movie. Unknown movies return
+// an error wrapping sql.ErrNoRows.
+func (r movie_service) GetTranslations(ctx context.Context, id string) (model.TranslationsResponse, error) {
+	movie, err := r.repo.GetMovieBasebyId(ctx, id, "en")
+	if err != nil {
+		return model.TranslationsResponse{}, fmt.Errorf("service: Get base movie: %w", err)
+	}
+
+	translations, err := r.repo.FetchTranslations(ctx, id)
+	if err != nil {
+		return model.TranslationsResponse{}, fmt.Errorf("service: FetchTranslations: %w", err)
+	}
+	return model.TranslationsResponse{ID: movie.ID, Translations: translations}, nil
+}
+
+// GetSimilar returns one page of the movies most like the given one. Unknown
+// movies return an error wrapping sql.ErrNoRows.
+func (r movie_service) GetSimilar(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) (model.DiscoverMoviesResponse, error) {
+	if _, err := r.repo.GetMovieBasebyId(ctx, id, lang); err != nil {
+		return model.DiscoverMoviesResponse{}, fmt.Errorf("service: Get base movie: %w", err)
+	}
+	return r.fetchSimilar(ctx, id, lang, includeAdult, page, pageSize)
+}
+
+func (r movie_service) fetchSimilar(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) (model.DiscoverMoviesResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	movies, total, err := r.repo.FetchSimilar(ctx, id, lang, includeAdult, page, pageSize)
+	if err != nil {
+		return model.DiscoverMoviesResponse{}, fmt.Errorf("service: FetchSimilar: %w", err)
+	}
+
+	res := model.DiscoverMoviesResponse{
+		Page:         page,
+		PageSize:     pageSize,
+		TotalResults: total,
+		Results:      movies,
+	}
+	if total > 0 {
+		res.TotalPages = int(math.Ceil(float64(total) / float64(pageSize)))
 	}
 	return res, nil
 }
 
+// groupImages splits images by type, keeping their order within each type.
+func groupImages(images []model.Image) *model.MovieImages {
+	grouped := &model.MovieImages{
+		Posters:   []model.Image{},
+		Backdrops: []model.Image{},
+		Stills:    []model.Image{},
+	}
+	for _, img := range images {
+		switch img.Type {
+		case "poster":
+			grouped.Posters = append(grouped.Posters, img)
+		case "backdrop":
+			grouped.Backdrops = append(grouped.Backdrops, img)
+		case "still":
+			grouped.Stills = append(grouped.Stills, img)
+		}
+	}
+	return grouped
+}
+
+// splitCredits splits credits into cast and crew; the repo already orders
+// cast by cast_order and crew by department, splitting keeps that order.
+func splitCredits(credits []model.MovieCredit) *model.MovieCredits {
+	mc := &model.MovieCredits{
+		Cast: []model.MovieCredit{},
+		Crew: []model.MovieCredit{},
+	}
+	for _, c := range credits {
+		if c.CreditType == "cast" {
+			mc.Cast = append(mc.Cast, c)
+		} else {
+			mc.Crew = append(mc.Crew, c)
+		}
+	}
+	return mc
+}
+
 func contains(list []string, s string) bool {
 	for _, item := range list {
 		if item == s {
@@ -145,30 +361,100 @@ func contains(list []string, s string) bool {
 
 //Search
 
-func (r movie_service) SearchMovie(ctx context.Context, searchQuery string, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int) (model.SearchResponse, error) {
+// SearchMovie runs the full-text search, or the trigram title match when fuzzy
+// is set. A full-text search with no results at all falls back to the fuzzy
+// match, so a misspelled title still finds something. A cursor from a previous
+// page continues that page's search, fuzzy or not, and replaces page.
+func (r movie_service) SearchMovie(ctx context.Context, searchQuery string, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int, fuzzy bool, cursor string) (model.SearchResponse, error) {
 
-	total, items, err := r.repo.SearchMovie(ctx, searchQuery, includeAdult, language, primaryYear, region, page, pageSize)
+	var (
+		total int
+		items []model.MovieSearchItem
+		err   error
+	)
 
-	if err != nil {
-		return model.SearchResponse{}, fmt.Errorf("service: SearchMovie : %w", err)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
 	}
 
-	totalPages := 0
-	if total > 0 {
-		totalPages = int(math.Ceil(float64(total) / float64(pageSize)))
+	key := searchCursorKey(searchQuery, language, includeAdult, primaryYear, region)
+	if cursor != "" {
+		c, err := pagination.DecodeForKey(cursor, key)
+		if err != nil {
+			return model.SearchResponse{}, fmt.Errorf("service: SearchMovie : %w", err)
+		}
+		fuzzy = c.Sort == pagination.SortFuzzySearch
 	}
 
-	return model.SearchResponse{
-		Page:         page,
-		TotalResults: total,
-		TotalPages:   totalPages,
-		Results:      items,
-	}, nil
+	if !fuzzy {
+		total, items, err = r.repo.SearchMovie(ctx, searchQuery, includeAdult, language, primaryYear, region, page, pageSize, cursor)
+		if err != nil {
+			return model.SearchResponse{}, fmt.Errorf("service: SearchMovie : %w", err)
+		}
+		// a page past the last result has no rows to count either, so only
+		// an empty first page means the query found nothing
+		fuzzy = total == 0 && cursor == "" && page == 1
+	}
+
+	if fuzzy {
+		total, items, err = r.repo.FuzzySearchMovie(ctx, searchQuery, includeAdult, language, primaryYear, region, page, pageSize, cursor)
+		if err != nil {
+			return model.SearchResponse{}, fmt.Errorf("service: FuzzySearchMovie : %w", err)
+		}
+	}
+
+	resp := model.SearchResponse{
+		Page:    page,
+		Fuzzy:   fuzzy,
+		Results: items,
+	}
+
+	// the repo returns one row past the page when there is a next one
+	if len(items) > pageSize {
+		last := items[pageSize-1]
+		sort := pagination.SortSearch
+		if fuzzy {
+			sort = pagination.SortFuzzySearch
+		}
+		resp.Results = items[:pageSize]
+		resp.NextCursor = pagination.Encode(pagination.Cursor{
+			Sort:  sort,
+			Value: ptr(strconv.FormatFloat(last.Score, 'g', -1, 64)),
+			ID:    last.ID.String(),
+			Key:   key,
+		})
+	}
+
+	// page numbers and totals don't apply once paging by cursor
+	if cursor == "" {
+		resp.TotalResults = total
+		if total > 0 {
+			resp.TotalPages = int(math.Ceil(float64(total) / float64(pageSize)))
+		}
+	}
+
+	return resp, nil
 }
 
 // discover
 func (r movie_service) Discover(ctx context.Context, params model.DiscoverMoviesParams) (model.DiscoverMoviesResponse, error) {
 
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.PageSize <= 0 || params.PageSize > 100 {
+		params.PageSize = 20
+	}
+
+	if params.Cursor != "" {
+		if _, err := pagination.DecodeForKey(params.Cursor, params.CursorKey()); err != nil {
+			return model.DiscoverMoviesResponse{}, fmt.Errorf("service: DiscoverMovie: %w", err)
+		}
+	}
+
 	resp, totalCount, err := r.repo.DiscoverMovies(ctx, params)
 
 	if err != nil {
@@ -182,8 +468,48 @@ func (r movie_service) Discover(ctx context.Context, params model.DiscoverMovies
 		TotalResults: totalCount,
 	}
 
+	// the repo returns one row past the page when there is a next one
+	if len(resp) > params.PageSize {
+		last := resp[params.PageSize-1]
+		field, dir := params.SortKey()
+		result.Results = resp[:params.PageSize]
+		result.NextCursor = pagination.Encode(pagination.Cursor{
+			Sort:  field + "." + dir,
+			Value: discoverSortValue(last, field),
+			ID:    last.ID.String(),
+			Key:   params.CursorKey(),
+		})
+	}
+
 	if totalCount > 0 {
 		result.TotalPages = int(math.Ceil(float64(totalCount) / float64(params.PageSize)))
 	}
 	return result, nil
 }
+
+// searchCursorKey is the pagination.QueryKey of a search's query and filters.
+func searchCursorKey(searchQuery, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString) string {
+	return pagination.QueryKey(searchQuery, language, includeAdult, primaryYear, region)
+}
+
+// discoverSortValue is the value of it in the discover sort column, as the
+// cursor carries it. nil stands for NULL.
+func discoverSortValue(it model.DiscoverItem, field string) *string {
+	var v *float64
+	switch field {
+	case "release_date":
+		return it.ReleaseDate
+	case "vote_average":
+		v = it.VoteAverage
+	default:
+		v = it.Popularity
+	}
+	if v == nil {
+		return nil
+	}
+	return ptr(strconv.FormatFloat(*v, 'g', -1, 64))
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
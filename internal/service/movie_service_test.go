@@ -5,19 +5,31 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
 	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/h-raju-arch/movie_app_backend/internal/pagination"
+	memoryrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/memory_repo"
 )
 
 // MockMovieRepo is a manual mock implementation of MovieRepository
 type MockMovieRepo struct {
-	GetMovieBasebyIdFunc func(ctx context.Context, id, lang string) (model.MovieResponse, error)
-	FetchGenresFunc      func(ctx context.Context, id string) ([]string, error)
-	FetchCompaniesFunc   func(ctx context.Context, id string) ([]string, error)
-	FetchCreditsFunc     func(ctx context.Context, id string) ([]model.Credits_Response, error)
-	SearchMovieFunc      func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int) (int, []model.MovieSearchItem, error)
-	DiscoverMoviesFunc   func(ctx context.Context, params model.DiscoverMoviesParams) ([]model.DiscoverItem, int, error)
+	GetMovieBasebyIdFunc     func(ctx context.Context, id, lang string) (model.MovieResponse, error)
+	FetchGenresFunc          func(ctx context.Context, id, lang string) ([]model.Genre, error)
+	FetchCompaniesFunc       func(ctx context.Context, id string) ([]model.ProductionCompany, error)
+	FetchCreditsFunc         func(ctx context.Context, id string) ([]model.MovieCredit, error)
+	FetchImagesFunc          func(ctx context.Context, id string, filter model.LanguageFilter) ([]model.Image, error)
+	FetchVideosFunc          func(ctx context.Context, id string, filter model.LanguageFilter) ([]model.Video, error)
+	FetchSpokenLanguagesFunc func(ctx context.Context, id string) ([]model.Language, error)
+	FetchTranslationsFunc    func(ctx context.Context, id string) ([]model.MovieTranslation, error)
+	FetchAccountStatesFunc   func(ctx context.Context, id string, userID uuid.UUID) (model.AccountStates, error)
+	FetchReviewsFunc         func(ctx context.Context, id string, viewer uuid.UUID, page, pageSize int) ([]model.Review, int, error)
+	SearchMovieFunc          func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error)
+	FuzzySearchMovieFunc     func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error)
+	DiscoverMoviesFunc       func(ctx context.Context, params model.DiscoverMoviesParams) ([]model.DiscoverItem, int, error)
+	FetchSimilarFunc         func(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) ([]model.DiscoverItem, int, error)
 }
 
 func (m *MockMovieRepo) GetMovieBasebyId(ctx context.Context, id, lang string) (model.MovieResponse, error) {
@@ -27,30 +39,86 @@ func (m *MockMovieRepo) GetMovieBasebyId(ctx context.Context, id, lang string) (
 	return model.MovieResponse{}, nil
 }
 
-func (m *MockMovieRepo) FetchGenres(ctx context.Context, id string) ([]string, error) {
+func (m *MockMovieRepo) FetchGenres(ctx context.Context, id, lang string) ([]model.Genre, error) {
 	if m.FetchGenresFunc != nil {
-		return m.FetchGenresFunc(ctx, id)
+		return m.FetchGenresFunc(ctx, id, lang)
 	}
 	return nil, nil
 }
 
-func (m *MockMovieRepo) FetchCompanies(ctx context.Context, id string) ([]string, error) {
+func (m *MockMovieRepo) FetchCompanies(ctx context.Context, id string) ([]model.ProductionCompany, error) {
 	if m.FetchCompaniesFunc != nil {
 		return m.FetchCompaniesFunc(ctx, id)
 	}
 	return nil, nil
 }
 
-func (m *MockMovieRepo) FetchCredits(ctx context.Context, id string) ([]model.Credits_Response, error) {
+func (m *MockMovieRepo) FetchCredits(ctx context.Context, id string) ([]model.MovieCredit, error) {
 	if m.FetchCreditsFunc != nil {
 		return m.FetchCreditsFunc(ctx, id)
 	}
 	return nil, nil
 }
 
-func (m *MockMovieRepo) SearchMovie(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int) (int, []model.MovieSearchItem, error) {
+func (m *MockMovieRepo) FetchImages(ctx context.Context, id string, filter model.LanguageFilter) ([]model.Image, error) {
+	if m.FetchImagesFunc != nil {
+		return m.FetchImagesFunc(ctx, id, filter)
+	}
+	return nil, nil
+}
+
+func (m *MockMovieRepo) FetchVideos(ctx context.Context, id string, filter model.LanguageFilter) ([]model.Video, error) {
+	if m.FetchVideosFunc != nil {
+		return m.FetchVideosFunc(ctx, id, filter)
+	}
+	return nil, nil
+}
+
+func (m *MockMovieRepo) FetchSpokenLanguages(ctx context.Context, id string) ([]model.Language, error) {
+	if m.FetchSpokenLanguagesFunc != nil {
+		return m.FetchSpokenLanguagesFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockMovieRepo) FetchTranslations(ctx context.Context, id string) ([]model.MovieTranslation, error) {
+	if m.FetchTranslationsFunc != nil {
+		return m.FetchTranslationsFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockMovieRepo) FetchAccountStates(ctx context.Context, id string, userID uuid.UUID) (model.AccountStates, error) {
+	if m.FetchAccountStatesFunc != nil {
+		return m.FetchAccountStatesFunc(ctx, id, userID)
+	}
+	return model.AccountStates{}, nil
+}
+
+func (m *MockMovieRepo) FetchReviews(ctx context.Context, id string, viewer uuid.UUID, page, pageSize int) ([]model.Review, int, error) {
+	if m.FetchReviewsFunc != nil {
+		return m.FetchReviewsFunc(ctx, id, viewer, page, pageSize)
+	}
+	return nil, 0, nil
+}
+
+func (m *MockMovieRepo) FetchSimilar(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) ([]model.DiscoverItem, int, error) {
+	if m.FetchSimilarFunc != nil {
+		return m.FetchSimilarFunc(ctx, id, lang, includeAdult, page, pageSize)
+	}
+	return nil, 0, nil
+}
+
+func (m *MockMovieRepo) SearchMovie(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
 	if m.SearchMovieFunc != nil {
-		return m.SearchMovieFunc(ctx, query, includeAdult, lang, year, region, page, pageSize)
+		return m.SearchMovieFunc(ctx, query, includeAdult, lang, year, region, page, pageSize, cursor)
+	}
+	return 0, nil, nil
+}
+
+func (m *MockMovieRepo) FuzzySearchMovie(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
+	if m.FuzzySearchMovieFunc != nil {
+		return m.FuzzySearchMovieFunc(ctx, query, includeAdult, lang, year, region, page, pageSize, cursor)
 	}
 	return 0, nil, nil
 }
@@ -83,7 +151,7 @@ func TestGetMovieById_Success(t *testing.T) {
 	}
 
 	svc := New_Movie_Service(mockRepo)
-	result, err := svc.GetMovieById(context.Background(), movieID.String(), "en", []string{})
+	result, err := svc.GetMovieById(context.Background(), movieID.String(), "en", []string{}, model.AppendOptions{})
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -102,19 +170,22 @@ func TestGetMovieById_WithGenres(t *testing.T) {
 		ID:    movieID,
 		Title: "Test Movie",
 	}
-	expectedGenres := []string{"Action", "Comedy"}
+	expectedGenres := []model.Genre{
+		{ID: uuid.Must(uuid.NewV4()), Name: "Action"},
+		{ID: uuid.Must(uuid.NewV4()), Name: "Comedy"},
+	}
 
 	mockRepo := &MockMovieRepo{
 		GetMovieBasebyIdFunc: func(ctx context.Context, id, lang string) (model.MovieResponse, error) {
 			return expectedMovie, nil
 		},
-		FetchGenresFunc: func(ctx context.Context, id string) ([]string, error) {
+		FetchGenresFunc: func(ctx context.Context, id, lang string) ([]model.Genre, error) {
 			return expectedGenres, nil
 		},
 	}
 
 	svc := New_Movie_Service(mockRepo)
-	result, err := svc.GetMovieById(context.Background(), movieID.String(), "en", []string{"genres"})
+	result, err := svc.GetMovieById(context.Background(), movieID.String(), "en", []string{"genres"}, model.AppendOptions{})
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -124,7 +195,7 @@ func TestGetMovieById_WithGenres(t *testing.T) {
 	}
 	for i, g := range expectedGenres {
 		if result.Genres[i] != g {
-			t.Errorf("expected genre %s at index %d, got %s", g, i, result.Genres[i])
+			t.Errorf("expected genre %v at index %d, got %v", g, i, result.Genres[i])
 		}
 	}
 }
@@ -135,19 +206,22 @@ func TestGetMovieById_WithCompanies(t *testing.T) {
 		ID:    movieID,
 		Title: "Test Movie",
 	}
-	expectedCompanies := []string{"Warner Bros", "Universal"}
+	expectedCompanies := []model.ProductionCompany{
+		{ID: uuid.Must(uuid.NewV4()), Name: "Warner Bros"},
+		{ID: uuid.Must(uuid.NewV4()), Name: "Universal"},
+	}
 
 	mockRepo := &MockMovieRepo{
 		GetMovieBasebyIdFunc: func(ctx context.Context, id, lang string) (model.MovieResponse, error) {
 			return expectedMovie, nil
 		},
-		FetchCompaniesFunc: func(ctx context.Context, id string) ([]string, error) {
+		FetchCompaniesFunc: func(ctx context.Context, id string) ([]model.ProductionCompany, error) {
 			return expectedCompanies, nil
 		},
 	}
 
 	svc := New_Movie_Service(mockRepo)
-	result, err := svc.GetMovieById(context.Background(), movieID.String(), "en", []string{"companies"})
+	result, err := svc.GetMovieById(context.Background(), movieID.String(), "en", []string{"companies"}, model.AppendOptions{})
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -163,28 +237,145 @@ func TestGetMovieById_WithCredits(t *testing.T) {
 		ID:    movieID,
 		Title: "Test Movie",
 	}
-	expectedCredits := []model.Credits_Response{
-		{Name: "Actor 1", Known_for: "Acting", Credit_type: "cast"},
-		{Name: "Director 1", Known_for: "Directing", Credit_type: "crew"},
+	expectedCredits := []model.MovieCredit{
+		{Name: "Actor 1", CreditType: "cast"},
+		{Name: "Actor 2", CreditType: "cast"},
+		{Name: "Director 1", CreditType: "crew"},
 	}
 
 	mockRepo := &MockMovieRepo{
 		GetMovieBasebyIdFunc: func(ctx context.Context, id, lang string) (model.MovieResponse, error) {
 			return expectedMovie, nil
 		},
-		FetchCreditsFunc: func(ctx context.Context, id string) ([]model.Credits_Response, error) {
+		FetchCreditsFunc: func(ctx context.Context, id string) ([]model.MovieCredit, error) {
 			return expectedCredits, nil
 		},
 	}
 
 	svc := New_Movie_Service(mockRepo)
-	result, err := svc.GetMovieById(context.Background(), movieID.String(), "en", []string{"credits"})
+	result, err := svc.GetMovieById(context.Background(), movieID.String(), "en", []string{"credits"}, model.AppendOptions{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Credits == nil {
+		t.Fatal("expected credits")
+	}
+	if len(result.Credits.Cast) != 2 || len(result.Credits.Crew) != 1 {
+		t.Errorf("expected 2 cast and 1 crew, got %d and %d", len(result.Credits.Cast), len(result.Credits.Crew))
+	}
+	if result.Credits.Cast[0].Name != "Actor 1" {
+		t.Errorf("expected repo order to be kept, got %s first", result.Credits.Cast[0].Name)
+	}
+}
+
+func TestGetMovieById_WithImages(t *testing.T) {
+	filter := model.LanguageFilter{Languages: []string{"en"}, IncludeNull: true}
+
+	mockRepo := &MockMovieRepo{
+		FetchImagesFunc: func(ctx context.Context, id string, f model.LanguageFilter) ([]model.Image, error) {
+			if !f.IncludeNull || len(f.Languages) != 1 {
+				t.Errorf("expected the image language filter to be passed on, got %+v", f)
+			}
+			return []model.Image{
+				{Type: "poster", FilePath: "/p.jpg", Width: 500, Height: 750},
+				{Type: "backdrop", FilePath: "/b1.jpg", Width: 1920, Height: 1080},
+				{Type: "backdrop", FilePath: "/b2.jpg", Width: 1280, Height: 720},
+			}, nil
+		},
+	}
+
+	svc := New_Movie_Service(mockRepo)
+	result, err := svc.GetMovieById(context.Background(), "id", "en", []string{"images"}, model.AppendOptions{ImageLanguages: filter})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Images == nil {
+		t.Fatal("expected images")
+	}
+	if len(result.Images.Posters) != 1 || len(result.Images.Backdrops) != 2 || len(result.Images.Stills) != 0 {
+		t.Errorf("expected 1 poster, 2 backdrops and no stills, got %+v", result.Images)
+	}
+	if result.Images.Backdrops[0].FilePath != "/b1.jpg" {
+		t.Errorf("expected repo order to be kept, got %s first", result.Images.Backdrops[0].FilePath)
+	}
+}
+
+func TestGetMovieById_ImagesMemoryRepo(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	svc := New_Movie_Service(memoryrepo.New_Memory_Repo(ds))
+	movie := ds.Movies[0]
+
+	all, err := svc.GetMovieById(context.Background(), movie.ID.String(), "en", []string{"images"}, model.AppendOptions{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(all.Images.Posters) != 1 || len(all.Images.Backdrops) != 1 || len(all.Images.Stills) != 3 {
+		t.Fatalf("expected 1 poster, 1 backdrop and 3 stills, got %+v", all.Images)
+	}
+	if p := all.Images.Posters[0]; p.AspectRatio < 0.66 || p.AspectRatio > 0.67 || *p.Language != movie.OriginalLanguage {
+		t.Errorf("unexpected poster %+v", p)
+	}
+
+	// posters carry the original language, backdrops and stills none
+	onlyNull, _ := svc.GetMovieById(context.Background(), movie.ID.String(), "en", []string{"images"},
+		model.AppendOptions{ImageLanguages: model.LanguageFilter{IncludeNull: true}})
+	if len(onlyNull.Images.Posters) != 0 || len(onlyNull.Images.Stills) != 3 {
+		t.Errorf("expected only language-less images, got %+v", onlyNull.Images)
+	}
+
+	onlyLang, _ := svc.GetMovieById(context.Background(), movie.ID.String(), "en", []string{"images"},
+		model.AppendOptions{ImageLanguages: model.LanguageFilter{Languages: []string{movie.OriginalLanguage}}})
+	if len(onlyLang.Images.Posters) != 1 || len(onlyLang.Images.Backdrops) != 0 {
+		t.Errorf("expected only the poster, got %+v", onlyLang.Images)
+	}
+}
+
+func TestGetMovieById_WithVideos(t *testing.T) {
+	mockRepo := &MockMovieRepo{
+		FetchVideosFunc: func(ctx context.Context, id string, f model.LanguageFilter) ([]model.Video, error) {
+			if len(f.Languages) != 2 || f.Languages[0] != "fr" || f.Languages[1] != "en" || !f.IncludeNull {
+				t.Errorf("expected fr plus en and null, got %+v", f)
+			}
+			return []model.Video{{Name: "Bande-annonce", Type: "Trailer"}}, nil
+		},
+	}
+
+	svc := New_Movie_Service(mockRepo)
+	opts := model.AppendOptions{VideoLanguages: model.LanguageFilter{Languages: []string{"en"}, IncludeNull: true}}
+	result, err := svc.GetMovieById(context.Background(), "id", "fr", []string{"videos"}, opts)
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
-	if len(result.Credits) != len(expectedCredits) {
-		t.Errorf("expected %d credits, got %d", len(expectedCredits), len(result.Credits))
+	if len(result.Videos) != 1 {
+		t.Errorf("expected 1 video, got %d", len(result.Videos))
+	}
+}
+
+func TestGetMovieById_VideosMemoryRepo(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	svc := New_Movie_Service(memoryrepo.New_Memory_Repo(ds))
+	movie := ds.Movies[0] // has translations and a teaser
+
+	es, err := svc.GetMovieById(context.Background(), movie.ID.String(), "es", []string{"videos"}, model.AppendOptions{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(es.Videos) != 1 || *es.Videos[0].Language != "es" {
+		t.Fatalf("expected only the spanish trailer, got %+v", es.Videos)
+	}
+
+	opts := model.AppendOptions{VideoLanguages: model.LanguageFilter{Languages: []string{"en"}, IncludeNull: true}}
+	all, _ := svc.GetMovieById(context.Background(), movie.ID.String(), "es", []string{"videos"}, opts)
+	if len(all.Videos) != 4 {
+		t.Fatalf("expected spanish, english and language-less videos, got %d", len(all.Videos))
+	}
+	for i := 1; i < len(all.Videos); i++ {
+		if *all.Videos[i-1].PublishedAt < *all.Videos[i].PublishedAt {
+			t.Errorf("expected newest videos first")
+		}
 	}
 }
 
@@ -196,7 +387,7 @@ func TestGetMovieById_RepoError(t *testing.T) {
 	}
 
 	svc := New_Movie_Service(mockRepo)
-	_, err := svc.GetMovieById(context.Background(), "invalid-id", "en", []string{})
+	_, err := svc.GetMovieById(context.Background(), "invalid-id", "en", []string{}, model.AppendOptions{})
 
 	if err == nil {
 		t.Fatal("expected error, got nil")
@@ -217,13 +408,13 @@ func TestGetMovieById_GenresError(t *testing.T) {
 		GetMovieBasebyIdFunc: func(ctx context.Context, id, lang string) (model.MovieResponse, error) {
 			return expectedMovie, nil
 		},
-		FetchGenresFunc: func(ctx context.Context, id string) ([]string, error) {
+		FetchGenresFunc: func(ctx context.Context, id, lang string) ([]model.Genre, error) {
 			return nil, errors.New("failed to fetch genres")
 		},
 	}
 
 	svc := New_Movie_Service(mockRepo)
-	_, err := svc.GetMovieById(context.Background(), movieID.String(), "en", []string{"genres"})
+	_, err := svc.GetMovieById(context.Background(), movieID.String(), "en", []string{"genres"}, model.AppendOptions{})
 
 	if err == nil {
 		t.Fatal("expected error, got nil")
@@ -238,7 +429,7 @@ func TestSearchMovie_Success(t *testing.T) {
 	}
 
 	mockRepo := &MockMovieRepo{
-		SearchMovieFunc: func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int) (int, []model.MovieSearchItem, error) {
+		SearchMovieFunc: func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
 			if query != "test" {
 				t.Errorf("expected query 'test', got %s", query)
 			}
@@ -247,7 +438,7 @@ func TestSearchMovie_Success(t *testing.T) {
 	}
 
 	svc := New_Movie_Service(mockRepo)
-	result, err := svc.SearchMovie(context.Background(), "test", "en", false, sql.NullInt64{}, sql.NullString{}, 1, 20)
+	result, err := svc.SearchMovie(context.Background(), "test", "en", false, sql.NullInt64{}, sql.NullString{}, 1, 20, false, "")
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -266,13 +457,13 @@ func TestSearchMovie_Pagination(t *testing.T) {
 	}
 
 	mockRepo := &MockMovieRepo{
-		SearchMovieFunc: func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int) (int, []model.MovieSearchItem, error) {
+		SearchMovieFunc: func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
 			return 45, items, nil
 		},
 	}
 
 	svc := New_Movie_Service(mockRepo)
-	result, err := svc.SearchMovie(context.Background(), "test", "en", false, sql.NullInt64{}, sql.NullString{}, 1, 20)
+	result, err := svc.SearchMovie(context.Background(), "test", "en", false, sql.NullInt64{}, sql.NullString{}, 1, 20, false, "")
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -291,13 +482,13 @@ func TestSearchMovie_Pagination(t *testing.T) {
 
 func TestSearchMovie_EmptyResult(t *testing.T) {
 	mockRepo := &MockMovieRepo{
-		SearchMovieFunc: func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int) (int, []model.MovieSearchItem, error) {
+		SearchMovieFunc: func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
 			return 0, []model.MovieSearchItem{}, nil
 		},
 	}
 
 	svc := New_Movie_Service(mockRepo)
-	result, err := svc.SearchMovie(context.Background(), "nonexistent", "en", false, sql.NullInt64{}, sql.NullString{}, 1, 20)
+	result, err := svc.SearchMovie(context.Background(), "nonexistent", "en", false, sql.NullInt64{}, sql.NullString{}, 1, 20, false, "")
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -312,13 +503,87 @@ func TestSearchMovie_EmptyResult(t *testing.T) {
 
 func TestSearchMovie_Error(t *testing.T) {
 	mockRepo := &MockMovieRepo{
-		SearchMovieFunc: func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int) (int, []model.MovieSearchItem, error) {
+		SearchMovieFunc: func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
+			return 0, nil, errors.New("database error")
+		},
+	}
+
+	svc := New_Movie_Service(mockRepo)
+	_, err := svc.SearchMovie(context.Background(), "test", "en", false, sql.NullInt64{}, sql.NullString{}, 1, 20, false, "")
+
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestSearchMovie_FuzzyFallback(t *testing.T) {
+	fuzzyCalled := false
+	mockRepo := &MockMovieRepo{
+		SearchMovieFunc: func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
+			return 0, nil, nil
+		},
+		FuzzySearchMovieFunc: func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
+			fuzzyCalled = true
+			return 1, []model.MovieSearchItem{{Title: "Interstellar"}}, nil
+		},
+	}
+
+	svc := New_Movie_Service(mockRepo)
+	result, err := svc.SearchMovie(context.Background(), "interstelar", "en", false, sql.NullInt64{}, sql.NullString{}, 1, 20, false, "")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !fuzzyCalled {
+		t.Error("expected fuzzy search after zero full-text results")
+	}
+	if !result.Fuzzy {
+		t.Error("expected response to be marked fuzzy")
+	}
+	if result.TotalResults != 1 {
+		t.Errorf("expected 1 total result, got %d", result.TotalResults)
+	}
+}
+
+func TestSearchMovie_PastLastPage(t *testing.T) {
+	mockRepo := &MockMovieRepo{
+		SearchMovieFunc: func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
+			// 3 matches, all on page 1
+			if page > 1 {
+				return 0, nil, nil
+			}
+			return 3, []model.MovieSearchItem{{Title: "Inception"}, {Title: "Interstellar"}, {Title: "Tenet"}}, nil
+		},
+		FuzzySearchMovieFunc: func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
+			t.Error("fuzzy search should only replace an empty first page")
+			return 1, []model.MovieSearchItem{{Title: "Unrelated"}}, nil
+		},
+	}
+
+	svc := New_Movie_Service(mockRepo)
+	result, err := svc.SearchMovie(context.Background(), "nolan", "en", false, sql.NullInt64{}, sql.NullString{}, 2, 20, false, "")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Fuzzy || len(result.Results) != 0 {
+		t.Errorf("expected an empty full-text page, got fuzzy=%v and %d results", result.Fuzzy, len(result.Results))
+	}
+}
+
+func TestSearchMovie_FuzzyRequested(t *testing.T) {
+	mockRepo := &MockMovieRepo{
+		SearchMovieFunc: func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
+			t.Error("full-text search should be skipped when fuzzy is requested")
+			return 0, nil, nil
+		},
+		FuzzySearchMovieFunc: func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
 			return 0, nil, errors.New("database error")
 		},
 	}
 
 	svc := New_Movie_Service(mockRepo)
-	_, err := svc.SearchMovie(context.Background(), "test", "en", false, sql.NullInt64{}, sql.NullString{}, 1, 20)
+	_, err := svc.SearchMovie(context.Background(), "godfater", "en", false, sql.NullInt64{}, sql.NullString{}, 1, 20, true, "")
 
 	if err == nil {
 		t.Fatal("expected error, got nil")
@@ -431,3 +696,312 @@ func TestContains(t *testing.T) {
 		})
 	}
 }
+
+// Tests against the in-memory repository instead of the mock
+func TestDiscover_MemoryRepo(t *testing.T) {
+	repo := memoryrepo.New_Memory_Repo(fixtures.Build(time.Now()))
+	svc := New_Movie_Service(repo)
+
+	params := model.DiscoverMoviesParams{
+		Language: "en",
+		SortBy:   "vote_average.desc",
+		Page:     1,
+		PageSize: 10,
+	}
+	result, err := svc.Discover(context.Background(), params)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result.Results) != 10 {
+		t.Errorf("expected 10 results, got %d", len(result.Results))
+	}
+	if result.TotalPages != 3 {
+		t.Errorf("expected 3 total pages, got %d", result.TotalPages)
+	}
+	if result.Results[0].Title != "The Shawshank Redemption" {
+		t.Errorf("expected highest rated movie first, got %s", result.Results[0].Title)
+	}
+}
+
+func TestDiscover_CursorPagination(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	svc := New_Movie_Service(memoryrepo.New_Memory_Repo(ds))
+
+	for _, sortBy := range []string{"popularity.desc", "vote_average.desc", "release_date.asc"} {
+		params := model.DiscoverMoviesParams{Language: "en", SortBy: sortBy, IncludeAdult: true, PageSize: 7}
+		all, _ := svc.Discover(context.Background(), model.DiscoverMoviesParams{Language: "en", SortBy: sortBy, IncludeAdult: true, PageSize: 100})
+
+		var walked []model.DiscoverItem
+		for pages := 0; ; pages++ {
+			if pages > len(ds.Movies) {
+				t.Fatalf("%s: cursor never ran out", sortBy)
+			}
+			result, err := svc.Discover(context.Background(), params)
+			if err != nil {
+				t.Fatalf("%s: expected no error, got %v", sortBy, err)
+			}
+			walked = append(walked, result.Results...)
+			if result.NextCursor == "" {
+				break
+			}
+			params.Cursor = result.NextCursor
+		}
+
+		if len(walked) != len(all.Results) {
+			t.Fatalf("%s: expected %d movies across pages, got %d", sortBy, len(all.Results), len(walked))
+		}
+		for i := range walked {
+			if walked[i].ID != all.Results[i].ID {
+				t.Errorf("%s: position %d differs from offset paging", sortBy, i)
+				break
+			}
+		}
+	}
+}
+
+func TestDiscover_CursorWrongSort(t *testing.T) {
+	svc := New_Movie_Service(memoryrepo.New_Memory_Repo(fixtures.Build(time.Now())))
+
+	first, _ := svc.Discover(context.Background(), model.DiscoverMoviesParams{SortBy: "popularity.desc", PageSize: 5})
+	if first.NextCursor == "" {
+		t.Fatal("expected a next cursor")
+	}
+
+	_, err := svc.Discover(context.Background(), model.DiscoverMoviesParams{SortBy: "vote_average.desc", PageSize: 5, Cursor: first.NextCursor})
+	if !errors.Is(err, pagination.ErrInvalidCursor) {
+		t.Errorf("expected ErrInvalidCursor, got %v", err)
+	}
+}
+
+func TestDiscover_CursorOtherFilters(t *testing.T) {
+	svc := New_Movie_Service(memoryrepo.New_Memory_Repo(fixtures.Build(time.Now())))
+	ctx := context.Background()
+
+	first, _ := svc.Discover(ctx, model.DiscoverMoviesParams{PageSize: 5})
+	if first.NextCursor == "" {
+		t.Fatal("expected a next cursor")
+	}
+
+	// a smaller page continues the same discover
+	if _, err := svc.Discover(ctx, model.DiscoverMoviesParams{PageSize: 2, Cursor: first.NextCursor}); err != nil {
+		t.Errorf("expected the cursor to continue, got %v", err)
+	}
+	_, err := svc.Discover(ctx, model.DiscoverMoviesParams{PageSize: 5, VoteAvgGTE: ptr(8.0), Cursor: first.NextCursor})
+	if !errors.Is(err, pagination.ErrInvalidCursor) {
+		t.Errorf("expected ErrInvalidCursor for other filters, got %v", err)
+	}
+}
+
+func TestSearchMovie_CursorOtherQuery(t *testing.T) {
+	svc := New_Movie_Service(memoryrepo.New_Memory_Repo(fixtures.Build(time.Now())))
+	ctx := context.Background()
+
+	first, _ := svc.SearchMovie(ctx, "world", "en", true, sql.NullInt64{}, sql.NullString{}, 1, 2, false, "")
+	if first.NextCursor == "" {
+		t.Fatal("expected a next cursor")
+	}
+
+	for name, search := range map[string]func() error{
+		"query": func() error {
+			_, err := svc.SearchMovie(ctx, "dream", "en", true, sql.NullInt64{}, sql.NullString{}, 1, 2, false, first.NextCursor)
+			return err
+		},
+		"include_adult": func() error {
+			_, err := svc.SearchMovie(ctx, "world", "en", false, sql.NullInt64{}, sql.NullString{}, 1, 2, false, first.NextCursor)
+			return err
+		},
+		"year": func() error {
+			_, err := svc.SearchMovie(ctx, "world", "en", true, sql.NullInt64{Int64: 2010, Valid: true}, sql.NullString{}, 1, 2, false, first.NextCursor)
+			return err
+		},
+	} {
+		if err := search(); !errors.Is(err, pagination.ErrInvalidCursor) {
+			t.Errorf("%s: expected ErrInvalidCursor, got %v", name, err)
+		}
+	}
+}
+
+func TestSearchMovie_CursorPagination(t *testing.T) {
+	svc := New_Movie_Service(memoryrepo.New_Memory_Repo(fixtures.Build(time.Now())))
+	ctx := context.Background()
+
+	all, _ := svc.SearchMovie(ctx, "world", "en", true, sql.NullInt64{}, sql.NullString{}, 1, 100, false, "")
+
+	seen := make(map[uuid.UUID]bool)
+	cursor := ""
+	for {
+		result, err := svc.SearchMovie(ctx, "world", "en", true, sql.NullInt64{}, sql.NullString{}, 1, 2, false, cursor)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		for _, it := range result.Results {
+			if seen[it.ID] {
+				t.Fatalf("%s returned twice", it.Title)
+			}
+			seen[it.ID] = true
+		}
+		if result.NextCursor == "" {
+			break
+		}
+		cursor = result.NextCursor
+	}
+	if len(seen) != all.TotalResults {
+		t.Errorf("expected %d results across pages, got %d", all.TotalResults, len(seen))
+	}
+}
+
+func TestSearchMovie_FuzzyCursor(t *testing.T) {
+	var fuzzyCursor string
+	mockRepo := &MockMovieRepo{
+		SearchMovieFunc: func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
+			t.Error("expected a fuzzy cursor to skip the full-text search")
+			return 0, nil, nil
+		},
+		FuzzySearchMovieFunc: func(ctx context.Context, query string, includeAdult bool, lang string, year sql.NullInt64, region sql.NullString, page, pageSize int, cursor string) (int, []model.MovieSearchItem, error) {
+			fuzzyCursor = cursor
+			return 1, []model.MovieSearchItem{{Title: "Interstellar"}}, nil
+		},
+	}
+
+	cursor := pagination.Encode(pagination.Cursor{
+		Sort: pagination.SortFuzzySearch, Value: ptr("0.5"), ID: uuid.Must(uuid.NewV4()).String(),
+		Key: searchCursorKey("interstelar", "en", false, sql.NullInt64{}, sql.NullString{}),
+	})
+	svc := New_Movie_Service(mockRepo)
+	result, err := svc.SearchMovie(context.Background(), "interstelar", "en", false, sql.NullInt64{}, sql.NullString{}, 1, 20, false, cursor)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !result.Fuzzy || fuzzyCursor != cursor {
+		t.Errorf("expected the cursor to be passed to the fuzzy search")
+	}
+	if result.TotalResults != 0 || result.NextCursor != "" {
+		t.Errorf("expected no totals or next cursor on the last cursor page, got %+v", result)
+	}
+}
+
+func TestGetMovieById_MemoryRepo(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	svc := New_Movie_Service(memoryrepo.New_Memory_Repo(ds))
+	movie := ds.Movies[0]
+
+	result, err := svc.GetMovieById(context.Background(), movie.ID.String(), "fr", []string{"genres", "companies", "credits"}, model.AppendOptions{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Title != movie.Title+" (Français)" {
+		t.Errorf("expected french title, got %s", result.Title)
+	}
+	if !result.UpdatedAt.Equal(movie.UpdatedAt) {
+		t.Errorf("expected updated_at %v, got %v", movie.UpdatedAt, result.UpdatedAt)
+	}
+	if len(result.Genres) != len(movie.GenreIDs) {
+		t.Errorf("expected %d genres, got %d", len(movie.GenreIDs), len(result.Genres))
+	}
+	if len(result.ProductionCompanies) != len(movie.CompanyIDs) {
+		t.Errorf("expected %d companies, got %d", len(movie.CompanyIDs), len(result.ProductionCompanies))
+	}
+	if result.Credits == nil || len(result.Credits.Cast) == 0 {
+		t.Error("expected credits")
+	}
+}
+
+func TestGetMovieById_SpokenLanguages(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	svc := New_Movie_Service(memoryrepo.New_Memory_Repo(ds))
+	movie := ds.Movies[0] // Inception: en, ja, fr
+
+	result, err := svc.GetMovieById(context.Background(), movie.ID.String(), "en", []string{"spoken_languages"}, model.AppendOptions{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result.SpokenLanguages) != 3 {
+		t.Fatalf("expected 3 spoken languages, got %v", result.SpokenLanguages)
+	}
+	// ordered by English name
+	want := []model.Language{
+		{ISO6391: "en", EnglishName: "English", Name: "English"},
+		{ISO6391: "fr", EnglishName: "French", Name: "Français"},
+		{ISO6391: "ja", EnglishName: "Japanese", Name: "日本語"},
+	}
+	for i, l := range want {
+		if result.SpokenLanguages[i] != l {
+			t.Errorf("expected %+v, got %+v", l, result.SpokenLanguages[i])
+		}
+	}
+
+	result, _ = svc.GetMovieById(context.Background(), movie.ID.String(), "en", nil, model.AppendOptions{})
+	if result.SpokenLanguages != nil {
+		t.Errorf("expected no spoken languages without the append, got %v", result.SpokenLanguages)
+	}
+}
+
+func TestGetTranslations_MemoryRepo(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	svc := New_Movie_Service(memoryrepo.New_Memory_Repo(ds))
+	movie := ds.Movies[0] // Inception has ja, es and fr translations
+
+	result, err := svc.GetTranslations(context.Background(), movie.ID.String())
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.ID != movie.ID {
+		t.Errorf("expected id %s, got %s", movie.ID, result.ID)
+	}
+	if len(result.Translations) != 3 {
+		t.Fatalf("expected 3 translations, got %d", len(result.Translations))
+	}
+	es := result.Translations[0]
+	if es.ISO6391 != "es" || es.EnglishName == nil || *es.EnglishName != "Spanish" || *es.Name != "Español" {
+		t.Errorf("expected Spanish annotated with its names first, got %+v", es)
+	}
+	if es.Data.Title == nil || *es.Data.Title != movie.Title+" (Español)" {
+		t.Errorf("expected the translated title, got %v", es.Data.Title)
+	}
+
+	withAppend, err := svc.GetMovieById(context.Background(), movie.ID.String(), "en", []string{"translations"}, model.AppendOptions{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(withAppend.Translations) != 3 {
+		t.Errorf("expected 3 appended translations, got %d", len(withAppend.Translations))
+	}
+}
+
+func TestGetTranslations_NotFound(t *testing.T) {
+	svc := New_Movie_Service(memoryrepo.New_Memory_Repo(fixtures.Build(time.Now())))
+
+	_, err := svc.GetTranslations(context.Background(), uuid.Must(uuid.NewV7()).String())
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+}
+
+func TestGetSimilar(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	svc := New_Movie_Service(memoryrepo.New_Memory_Repo(ds))
+	inception := ds.Movies[0].ID.String()
+
+	res, err := svc.GetSimilar(context.Background(), inception, "en", false, 1, 5)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if res.Page != 1 || res.PageSize != 5 || len(res.Results) != 5 || res.TotalPages != (res.TotalResults+4)/5 {
+		t.Errorf("unexpected page %+v", res)
+	}
+
+	movie, _ := svc.GetMovieById(context.Background(), inception, "en", []string{"similar"}, model.AppendOptions{})
+	if movie.Similar == nil || movie.Similar.TotalResults != res.TotalResults || movie.Similar.Results[0].ID != res.Results[0].ID {
+		t.Errorf("expected the append to match page 1, got %+v", movie.Similar)
+	}
+
+	if _, err := svc.GetSimilar(context.Background(), uuid.Must(uuid.NewV7()).String(), "en", false, 1, 20); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows for an unknown movie, got %v", err)
+	}
+}
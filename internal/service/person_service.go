@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	personrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/person_repo"
+)
+
+type Person_Service interface {
+	GetPersonById(ctx context.Context, id, lang string, appendtoresponse []string) (model.PersonResponse, error)
+}
+
+type person_service struct {
+	repo personrepo.PersonRepository
+}
+
+func New_Person_Service(r personrepo.PersonRepository) *person_service {
+	return &person_service{repo: r}
+}
+
+// GetPersonById returns the person's profile. With "movie_credits" in
+// appendtoresponse it also returns their filmography, split into cast and
+// crew, with movie titles in lang.
+func (r person_service) GetPersonById(ctx context.Context, id, lang string, appendtoresponse []string) (model.PersonResponse, error) {
+	person, err := r.repo.GetPersonById(ctx, id)
+	if err != nil {
+		return model.PersonResponse{}, fmt.Errorf("service: Get person: %w", err)
+	}
+
+	if contains(appendtoresponse, "movie_credits") {
+		credits, err := r.repo.FetchMovieCredits(ctx, id, lang)
+		if err != nil {
+			return model.PersonResponse{}, fmt.Errorf("service: FetchMovieCredits: %w", err)
+		}
+
+		// the repo already sorts by release date, splitting keeps that order
+		mc := &model.PersonMovieCredits{
+			Cast: []model.PersonMovieCredit{},
+			Crew: []model.PersonMovieCredit{},
+		}
+		for _, c := range credits {
+			if c.CreditType == "cast" {
+				mc.Cast = append(mc.Cast, c)
+			} else {
+				mc.Crew = append(mc.Crew, c)
+			}
+		}
+		person.MovieCredits = mc
+	}
+
+	return person, nil
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	memoryrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/memory_repo"
+)
+
+// MockPersonRepo is a manual mock implementation of PersonRepository
+type MockPersonRepo struct {
+	GetPersonByIdFunc     func(ctx context.Context, id string) (model.PersonResponse, error)
+	FetchMovieCreditsFunc func(ctx context.Context, id, lang string) ([]model.PersonMovieCredit, error)
+}
+
+func (m *MockPersonRepo) GetPersonById(ctx context.Context, id string) (model.PersonResponse, error) {
+	if m.GetPersonByIdFunc != nil {
+		return m.GetPersonByIdFunc(ctx, id)
+	}
+	return model.PersonResponse{}, nil
+}
+
+func (m *MockPersonRepo) FetchMovieCredits(ctx context.Context, id, lang string) ([]model.PersonMovieCredit, error) {
+	if m.FetchMovieCreditsFunc != nil {
+		return m.FetchMovieCreditsFunc(ctx, id, lang)
+	}
+	return nil, nil
+}
+
+func TestGetPersonById_Success(t *testing.T) {
+	personID := uuid.Must(uuid.NewV4())
+	mockRepo := &MockPersonRepo{
+		GetPersonByIdFunc: func(ctx context.Context, id string) (model.PersonResponse, error) {
+			return model.PersonResponse{ID: personID, Name: "Christopher Nolan"}, nil
+		},
+		FetchMovieCreditsFunc: func(ctx context.Context, id, lang string) ([]model.PersonMovieCredit, error) {
+			t.Error("expected movie credits not to be fetched without append")
+			return nil, nil
+		},
+	}
+
+	svc := New_Person_Service(mockRepo)
+	result, err := svc.GetPersonById(context.Background(), personID.String(), "en", nil)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Name != "Christopher Nolan" {
+		t.Errorf("expected name 'Christopher Nolan', got %s", result.Name)
+	}
+	if result.MovieCredits != nil {
+		t.Error("expected no movie credits")
+	}
+}
+
+func TestGetPersonById_MovieCredits(t *testing.T) {
+	mockRepo := &MockPersonRepo{
+		FetchMovieCreditsFunc: func(ctx context.Context, id, lang string) ([]model.PersonMovieCredit, error) {
+			if lang != "fr" {
+				t.Errorf("expected lang 'fr', got %s", lang)
+			}
+			return []model.PersonMovieCredit{
+				{Title: "Memento", CreditType: "crew", Job: ptr("Director")},
+				{Title: "Inception", CreditType: "cast", Character: ptr("Cameo")},
+				{Title: "Inception", CreditType: "crew", Job: ptr("Director")},
+			}, nil
+		},
+	}
+
+	svc := New_Person_Service(mockRepo)
+	result, err := svc.GetPersonById(context.Background(), "id", "fr", []string{"movie_credits"})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.MovieCredits == nil {
+		t.Fatal("expected movie credits")
+	}
+	if len(result.MovieCredits.Cast) != 1 || len(result.MovieCredits.Crew) != 2 {
+		t.Errorf("expected 1 cast and 2 crew credits, got %d and %d", len(result.MovieCredits.Cast), len(result.MovieCredits.Crew))
+	}
+	if result.MovieCredits.Crew[0].Title != "Memento" {
+		t.Errorf("expected repo order to be kept, got %s first", result.MovieCredits.Crew[0].Title)
+	}
+}
+
+func TestGetPersonById_Error(t *testing.T) {
+	mockRepo := &MockPersonRepo{
+		GetPersonByIdFunc: func(ctx context.Context, id string) (model.PersonResponse, error) {
+			return model.PersonResponse{}, errors.New("not found")
+		},
+	}
+
+	svc := New_Person_Service(mockRepo)
+	_, err := svc.GetPersonById(context.Background(), "id", "en", []string{"movie_credits"})
+
+	if err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func TestGetPersonById_MemoryRepo(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	svc := New_Person_Service(memoryrepo.New_Memory_Repo(ds))
+
+	var nolan fixtures.Person
+	for _, p := range ds.People {
+		if p.Name == "Christopher Nolan" {
+			nolan = p
+		}
+	}
+
+	result, err := svc.GetPersonById(context.Background(), nolan.ID.String(), "es", []string{"movie_credits"})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	crew := result.MovieCredits.Crew
+	if len(crew) < 2 {
+		t.Fatalf("expected several crew credits, got %d", len(crew))
+	}
+	for i := 1; i < len(crew); i++ {
+		if *crew[i-1].ReleaseDate > *crew[i].ReleaseDate {
+			t.Errorf("expected credits sorted by release date, got %s before %s", *crew[i-1].ReleaseDate, *crew[i].ReleaseDate)
+		}
+	}
+	found := false
+	for _, c := range crew {
+		if c.Title == "Inception (Español)" && *c.Job == "Director" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected translated Inception directing credit")
+	}
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	ratingrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/rating_repo"
+)
+
+const (
+	minRating  = 0.5
+	maxRating  = 10
+	ratingStep = 0.5
+)
+
+var ratingRangeMessage = fmt.Sprintf("must be between %g and %g in steps of %g", minRating, float64(maxRating), ratingStep)
+
+// validRating reports whether v is a rating users may give.
+func validRating(v float64) bool {
+	return v >= minRating && v <= maxRating && math.Mod(v, ratingStep) == 0
+}
+
+type Rating_Service interface {
+	RateMovie(ctx context.Context, userID uuid.UUID, movieID string, w model.RatingWrite) (model.MovieRating, bool, error)
+	DeleteRating(ctx context.Context, userID uuid.UUID, movieID string) (model.MovieRating, error)
+	GetRatedMovies(ctx context.Context, userID uuid.UUID, lang string, page, pageSize int) (model.RatedMoviesResponse, error)
+}
+
+type rating_service struct {
+	repo ratingrepo.RatingRepository
+}
+
+func New_Rating_Service(r ratingrepo.RatingRepository) *rating_service {
+	return &rating_service{repo: r}
+}
+
+// RateMovie stores the user's rating of a movie, replacing any earlier one,
+// and reports whether it is their first rating of it.
+func (r rating_service) RateMovie(ctx context.Context, userID uuid.UUID, movieID string, w model.RatingWrite) (model.MovieRating, bool, error) {
+	if !validRating(w.Value) {
+		verr := &model.ValidationError{}
+		verr.Add("value", ratingRangeMessage)
+		return model.MovieRating{}, false, verr
+	}
+
+	res, created, err := r.repo.RateMovie(ctx, userID, movieID, w.Value)
+	if err != nil {
+		return model.MovieRating{}, false, fmt.Errorf("service: RateMovie: %w", err)
+	}
+	return res, created, nil
+}
+
+func (r rating_service) DeleteRating(ctx context.Context, userID uuid.UUID, movieID string) (model.MovieRating, error) {
+	res, err := r.repo.DeleteRating(ctx, userID, movieID)
+	if err != nil {
+		return model.MovieRating{}, fmt.Errorf("service: DeleteRating: %w", err)
+	}
+	return res, nil
+}
+
+// GetRatedMovies returns one page of the movies the user rated, most
+// recently rated first.
+func (r rating_service) GetRatedMovies(ctx context.Context, userID uuid.UUID, lang string, page, pageSize int) (model.RatedMoviesResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	movies, total, err := r.repo.FetchRatedMovies(ctx, userID, lang, page, pageSize)
+	if err != nil {
+		return model.RatedMoviesResponse{}, fmt.Errorf("service: FetchRatedMovies: %w", err)
+	}
+
+	res := model.RatedMoviesResponse{
+		Page:         page,
+		PageSize:     pageSize,
+		TotalResults: total,
+		Results:      movies,
+	}
+	if total > 0 {
+		res.TotalPages = int(math.Ceil(float64(total) / float64(pageSize)))
+	}
+	return res, nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	memoryrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/memory_repo"
+)
+
+func TestRateMovie_UpdatesVoteAggregates(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	svc := New_Rating_Service(mem)
+	ctx := context.Background()
+	movie := ds.Movies[0]
+	movieID := movie.ID.String()
+	ada, bob := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	sum := movie.VoteAverage * float64(movie.VoteCount)
+
+	res, created, err := svc.RateMovie(ctx, ada, movieID, model.RatingWrite{Value: 1})
+	if err != nil || !created {
+		t.Fatalf("expected a new rating, got %v, %v", created, err)
+	}
+	if res.VoteCount != movie.VoteCount+1 || math.Abs(res.VoteAverage-(sum+1)/float64(movie.VoteCount+1)) > 1e-9 {
+		t.Errorf("expected the rating folded into the average, got %+v", res)
+	}
+
+	// changing a rating keeps the count
+	res, created, _ = svc.RateMovie(ctx, ada, movieID, model.RatingWrite{Value: 9.5})
+	if created || res.VoteCount != movie.VoteCount+1 || math.Abs(res.VoteAverage-(sum+9.5)/float64(movie.VoteCount+1)) > 1e-9 {
+		t.Errorf("expected the rating replaced, got %v %+v", created, res)
+	}
+
+	svc.RateMovie(ctx, bob, movieID, model.RatingWrite{Value: 3})
+	res, err = svc.DeleteRating(ctx, ada, movieID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if res.Rating != nil || res.VoteCount != movie.VoteCount+1 || math.Abs(res.VoteAverage-(sum+3)/float64(movie.VoteCount+1)) > 1e-9 {
+		t.Errorf("expected only bob's rating left, got %+v", res)
+	}
+
+	// discover sees the new average
+	got, _ := New_Movie_Service(mem).GetMovieById(ctx, movieID, "en", nil, model.AppendOptions{})
+	if *got.VoteAverage != res.VoteAverage || *got.VoteCount != res.VoteCount {
+		t.Errorf("expected movie_stats updated, got %v / %v", *got.VoteAverage, *got.VoteCount)
+	}
+	// and so does an incremental export
+	if !got.UpdatedAt.After(movie.UpdatedAt) {
+		t.Errorf("expected votes to bump updated_at, got %v", got.UpdatedAt)
+	}
+
+	if _, err := svc.DeleteRating(ctx, ada, movieID); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows deleting twice, got %v", err)
+	}
+}
+
+func TestRateMovie_AdminWritesKeepVotes(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	svc := New_Rating_Service(mem)
+	admin := New_Admin_Service(mem, mem, mem)
+	ctx := context.Background()
+	movie := ds.Movies[0]
+	movieID := movie.ID.String()
+	user := uuid.Must(uuid.NewV7())
+
+	if _, _, err := svc.RateMovie(ctx, user, movieID, model.RatingWrite{Value: 2}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	replaced, err := admin.ReplaceMovie(ctx, movieID, model.MovieWrite{Title: ptr("Inception"), Popularity: ptr(50.0)})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if *replaced.VoteCount != movie.VoteCount+1 {
+		t.Errorf("expected the PUT to keep the vote, got %d votes", *replaced.VoteCount)
+	}
+
+	res, err := svc.DeleteRating(ctx, user, movieID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if res.VoteCount != movie.VoteCount || math.Abs(res.VoteAverage-movie.VoteAverage) > 1e-9 {
+		t.Errorf("expected the seeded %v / %d back, got %+v", movie.VoteAverage, movie.VoteCount, res)
+	}
+	got, _ := New_Movie_Service(mem).GetMovieById(ctx, movieID, "en", nil, model.AppendOptions{})
+	if *got.VoteCount != res.VoteCount || *got.VoteAverage != res.VoteAverage {
+		t.Errorf("expected movie_stats to match, got %v / %v", *got.VoteAverage, *got.VoteCount)
+	}
+}
+
+func TestRateMovie_OnlyVote(t *testing.T) {
+	mem := memoryrepo.New_Memory_Repo(nil)
+	movieID := uuid.Must(uuid.NewV7())
+	mem.CreateMovie(context.Background(), movieID, model.MovieWrite{Title: ptr("Unrated")})
+	svc := New_Rating_Service(mem)
+	user := uuid.Must(uuid.NewV7())
+
+	res, _, _ := svc.RateMovie(context.Background(), user, movieID.String(), model.RatingWrite{Value: 7.5})
+	if res.VoteAverage != 7.5 || res.VoteCount != 1 {
+		t.Errorf("expected 7.5 from one vote, got %+v", res)
+	}
+	res, _ = svc.DeleteRating(context.Background(), user, movieID.String())
+	if res.VoteAverage != 0 || res.VoteCount != 0 {
+		t.Errorf("expected no votes left, got %+v", res)
+	}
+}
+
+func TestRateMovie_Validation(t *testing.T) {
+	svc := New_Rating_Service(memoryrepo.New_Memory_Repo(nil))
+
+	for _, v := range []float64{0, 0.25, 7.3, 10.5, -1} {
+		_, _, err := svc.RateMovie(context.Background(), uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7()).String(), model.RatingWrite{Value: v})
+		var verr *model.ValidationError
+		if !errors.As(err, &verr) {
+			t.Errorf("%g: expected a validation error, got %v", v, err)
+		}
+	}
+
+	_, _, err := svc.RateMovie(context.Background(), uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7()).String(), model.RatingWrite{Value: 5})
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows for an unknown movie, got %v", err)
+	}
+}
+
+func TestGetRatedMovies(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	svc := New_Rating_Service(mem)
+	ctx := context.Background()
+	user := uuid.Must(uuid.NewV7())
+
+	for i := range 3 {
+		svc.RateMovie(ctx, user, ds.Movies[i].ID.String(), model.RatingWrite{Value: float64(i + 1)})
+		time.Sleep(time.Millisecond)
+	}
+	svc.RateMovie(ctx, uuid.Must(uuid.NewV7()), ds.Movies[5].ID.String(), model.RatingWrite{Value: 5})
+
+	res, err := svc.GetRatedMovies(ctx, user, "en", 1, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if res.TotalResults != 3 || res.TotalPages != 2 || len(res.Results) != 2 {
+		t.Fatalf("expected 3 rated movies over 2 pages, got %+v", res)
+	}
+	if res.Results[0].ID != ds.Movies[2].ID || res.Results[0].Rating != 3 {
+		t.Errorf("expected the latest rating first, got %s rated %g", res.Results[0].Title, res.Results[0].Rating)
+	}
+}
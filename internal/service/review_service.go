@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	movierepo "github.com/h-raju-arch/movie_app_backend/internal/repo/movie_repo"
+	reviewrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/review_repo"
+)
+
+const maxReviewLength = 10000 // characters
+
+type Review_Service interface {
+	WriteReview(ctx context.Context, authorID uuid.UUID, movieID string, w model.ReviewWrite) (model.Review, bool, error)
+	GetReviews(ctx context.Context, movieID string, viewer uuid.UUID, page, pageSize int) (model.ReviewsResponse, error)
+	ListReviews(ctx context.Context, status string, page, pageSize int) (model.ReviewsResponse, error)
+	SetReviewStatus(ctx context.Context, id, status string) (model.Review, error)
+}
+
+type review_service struct {
+	repo   reviewrepo.ReviewRepository
+	movies movierepo.MovieRepository
+}
+
+func New_Review_Service(r reviewrepo.ReviewRepository, movies movierepo.MovieRepository) *review_service {
+	return &review_service{repo: r, movies: movies}
+}
+
+// WriteReview stores the author's review of a movie, replacing their
+// earlier one, and reports whether it is new. Either way it waits for
+// moderation before it is public.
+func (r review_service) WriteReview(ctx context.Context, authorID uuid.UUID, movieID string, w model.ReviewWrite) (model.Review, bool, error) {
+	w.Content = strings.TrimSpace(w.Content)
+
+	verr := &model.ValidationError{}
+	if w.Rating != nil && !validRating(*w.Rating) {
+		verr.Add("rating", ratingRangeMessage)
+	}
+	switch {
+	case w.Content == "":
+		verr.Add("content", "is required")
+	case utf8.RuneCountInString(w.Content) > maxReviewLength:
+		verr.Add("content", fmt.Sprintf("must be at most %d characters", maxReviewLength))
+	}
+	if err := verr.OrNil(); err != nil {
+		return model.Review{}, false, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return model.Review{}, false, fmt.Errorf("service: new review id: %w", err)
+	}
+	res, created, err := r.repo.SaveReview(ctx, id, movieID, authorID, w)
+	if err != nil {
+		return model.Review{}, false, fmt.Errorf("service: SaveReview: %w", err)
+	}
+	return res, created, nil
+}
+
+// GetReviews returns one page of a movie's approved reviews, plus the
+// viewer's own (uuid.Nil for anonymous viewers). Unknown movies return an
+// error wrapping sql.ErrNoRows.
+func (r review_service) GetReviews(ctx context.Context, movieID string, viewer uuid.UUID, page, pageSize int) (model.ReviewsResponse, error) {
+	if _, err := r.movies.GetMovieBasebyId(ctx, movieID, "en"); err != nil {
+		return model.ReviewsResponse{}, fmt.Errorf("service: Get base movie: %w", err)
+	}
+	return fetchReviews(ctx, r.movies, movieID, viewer, page, pageSize)
+}
+
+// fetchReviews is the reviews append and GetReviews without the movie
+// lookup.
+func fetchReviews(ctx context.Context, movies movierepo.MovieRepository, movieID string, viewer uuid.UUID, page, pageSize int) (model.ReviewsResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	reviews, total, err := movies.FetchReviews(ctx, movieID, viewer, page, pageSize)
+	if err != nil {
+		return model.ReviewsResponse{}, fmt.Errorf("service: FetchReviews: %w", err)
+	}
+	return reviewsPage(reviews, total, page, pageSize), nil
+}
+
+// ListReviews returns one page of the reviews with status, oldest first,
+// for moderation.
+func (r review_service) ListReviews(ctx context.Context, status string, page, pageSize int) (model.ReviewsResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	reviews, total, err := r.repo.FetchReviewsByStatus(ctx, status, page, pageSize)
+	if err != nil {
+		return model.ReviewsResponse{}, fmt.Errorf("service: FetchReviewsByStatus: %w", err)
+	}
+	return reviewsPage(reviews, total, page, pageSize), nil
+}
+
+// SetReviewStatus approves or rejects a review. Unknown ids return an error
+// wrapping sql.ErrNoRows.
+func (r review_service) SetReviewStatus(ctx context.Context, id, status string) (model.Review, error) {
+	if !model.ValidReviewStatus(status) {
+		verr := &model.ValidationError{}
+		verr.Add("status", "must be pending, approved or rejected")
+		return model.Review{}, verr
+	}
+
+	res, err := r.repo.SetReviewStatus(ctx, id, status)
+	if err != nil {
+		return model.Review{}, fmt.Errorf("service: SetReviewStatus: %w", err)
+	}
+	return res, nil
+}
+
+func reviewsPage(reviews []model.Review, total, page, pageSize int) model.ReviewsResponse {
+	res := model.ReviewsResponse{
+		Page:         page,
+		PageSize:     pageSize,
+		TotalResults: total,
+		Results:      reviews,
+	}
+	if total > 0 {
+		res.TotalPages = int(math.Ceil(float64(total) / float64(pageSize)))
+	}
+	return res
+}
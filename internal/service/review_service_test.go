@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/auth"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	memoryrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/memory_repo"
+)
+
+func TestWriteReview_Moderation(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	svc := New_Review_Service(mem, mem)
+	ctx := context.Background()
+	movieID := ds.Movies[0].ID.String()
+	ada, bob := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+
+	rv, created, err := svc.WriteReview(ctx, ada, movieID, model.ReviewWrite{Rating: ptr(9.0), Content: "  A dream within a dream.  "})
+	if err != nil || !created {
+		t.Fatalf("expected a new review, got %v, %v", created, err)
+	}
+	if rv.Status != model.ReviewPending || rv.Content != "A dream within a dream." || *rv.Rating != 9 {
+		t.Errorf("expected a trimmed pending review, got %+v", rv)
+	}
+
+	// pending reviews are only shown to their author
+	if res, _ := svc.GetReviews(ctx, movieID, uuid.Nil, 1, 20); res.TotalResults != 0 {
+		t.Errorf("expected no public reviews, got %d", res.TotalResults)
+	}
+	if res, _ := svc.GetReviews(ctx, movieID, bob, 1, 20); res.TotalResults != 0 {
+		t.Errorf("expected other users not to see it, got %d", res.TotalResults)
+	}
+	if res, _ := svc.GetReviews(ctx, movieID, ada, 1, 20); res.TotalResults != 1 {
+		t.Errorf("expected the author to see it, got %d", res.TotalResults)
+	}
+
+	queue, _ := svc.ListReviews(ctx, model.ReviewPending, 1, 20)
+	if queue.TotalResults != 1 || queue.Results[0].ID != rv.ID {
+		t.Fatalf("expected the review in the moderation queue, got %+v", queue)
+	}
+	if _, err := svc.SetReviewStatus(ctx, rv.ID.String(), model.ReviewApproved); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if res, _ := svc.GetReviews(ctx, movieID, uuid.Nil, 1, 20); res.TotalResults != 1 {
+		t.Errorf("expected the approved review to be public, got %d", res.TotalResults)
+	}
+	// the movie's public reviews changed, so it was updated
+	updatedAt := func() time.Time {
+		movie, _ := New_Movie_Service(mem).GetMovieById(ctx, movieID, "en", nil, model.AppendOptions{})
+		return movie.UpdatedAt
+	}
+	approvedAt := updatedAt()
+	if !approvedAt.After(ds.Movies[0].UpdatedAt) {
+		t.Errorf("expected approval to bump updated_at, got %v", approvedAt)
+	}
+
+	// editing sends it back to moderation under the same id
+	edited, created, _ := svc.WriteReview(ctx, ada, movieID, model.ReviewWrite{Content: "Better the second time."})
+	if created || edited.ID != rv.ID || edited.Status != model.ReviewPending || edited.Rating != nil || !edited.CreatedAt.Equal(rv.CreatedAt) {
+		t.Errorf("expected the review replaced and pending, got %v %+v", created, edited)
+	}
+	if res, _ := svc.GetReviews(ctx, movieID, uuid.Nil, 1, 20); res.TotalResults != 0 {
+		t.Errorf("expected the edited review hidden again, got %d", res.TotalResults)
+	}
+	hiddenAt := updatedAt()
+	if !hiddenAt.After(approvedAt) {
+		t.Error("expected hiding the approved review to bump updated_at")
+	}
+
+	// rejecting a pending review leaves the public reviews as they were
+	if _, err := svc.SetReviewStatus(ctx, rv.ID.String(), model.ReviewRejected); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := updatedAt(); !got.Equal(hiddenAt) {
+		t.Errorf("expected rejecting a pending review to keep updated_at, got %v", got)
+	}
+
+	if _, err := svc.SetReviewStatus(ctx, uuid.Must(uuid.NewV7()).String(), model.ReviewRejected); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows for an unknown review, got %v", err)
+	}
+	if _, err := svc.GetReviews(ctx, uuid.Must(uuid.NewV7()).String(), uuid.Nil, 1, 20); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows for an unknown movie, got %v", err)
+	}
+}
+
+func TestWriteReview_Validation(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	svc := New_Review_Service(mem, mem)
+
+	for name, tt := range map[string]struct {
+		w     model.ReviewWrite
+		field string
+	}{
+		"empty":    {model.ReviewWrite{Content: "   "}, "content"},
+		"too long": {model.ReviewWrite{Content: strings.Repeat("é", maxReviewLength+1)}, "content"},
+		"rating":   {model.ReviewWrite{Rating: ptr(10.5), Content: "Great"}, "rating"},
+	} {
+		_, _, err := svc.WriteReview(context.Background(), uuid.Must(uuid.NewV7()), ds.Movies[0].ID.String(), tt.w)
+		var verr *model.ValidationError
+		if !errors.As(err, &verr) || verr.Errors[0].Field != tt.field {
+			t.Errorf("%s: expected a validation error on %s, got %v", name, tt.field, err)
+		}
+	}
+
+	_, _, err := svc.WriteReview(context.Background(), uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7()).String(), model.ReviewWrite{Content: "Great"})
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows for an unknown movie, got %v", err)
+	}
+}
+
+func TestGetMovieById_Reviews(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	reviews := New_Review_Service(mem, mem)
+	ctx := context.Background()
+	movieID := ds.Movies[0].ID.String()
+	ada, bob := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+
+	approved, _, _ := reviews.WriteReview(ctx, ada, movieID, model.ReviewWrite{Content: "Approved"})
+	reviews.SetReviewStatus(ctx, approved.ID.String(), model.ReviewApproved)
+	time.Sleep(time.Millisecond)
+	reviews.WriteReview(ctx, bob, movieID, model.ReviewWrite{Content: "Pending"})
+
+	res, err := New_Movie_Service(mem).GetMovieById(auth.WithUserID(ctx, bob), movieID, "en", []string{"reviews"}, model.AppendOptions{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if res.Reviews == nil || res.Reviews.TotalResults != 2 || res.Reviews.Results[0].Content != "Pending" {
+		t.Errorf("expected bob's pending review first, then the approved one, got %+v", res.Reviews)
+	}
+
+	res, _ = New_Movie_Service(mem).GetMovieById(ctx, movieID, "en", []string{"reviews"}, model.AppendOptions{})
+	if res.Reviews == nil || res.Reviews.TotalResults != 1 {
+		t.Errorf("expected only the approved review anonymously, got %+v", res.Reviews)
+	}
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	viewrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/view_repo"
+)
+
+// TrendingWindow is a time window trending movies are ranked over: the
+// views of the last Span, halving in weight every HalfLife.
+type TrendingWindow struct {
+	Span     time.Duration
+	HalfLife time.Duration
+}
+
+// TrendingWindows are the windows GetTrending accepts, by name.
+var TrendingWindows = map[string]TrendingWindow{
+	"day":  {Span: 24 * time.Hour, HalfLife: 6 * time.Hour},
+	"week": {Span: 7 * 24 * time.Hour, HalfLife: 2 * 24 * time.Hour},
+}
+
+// popularityWindow is the window movie_stats popularity is recomputed over.
+const popularityWindow = "week"
+
+// TrendingConfig tunes how views are batched and popularity refreshed.
+type TrendingConfig struct {
+	BufferSize      int           // views queued before RecordView starts dropping them
+	BatchSize       int           // movie-hours buffered before they are written early
+	FlushInterval   time.Duration // how often buffered views are written
+	RefreshInterval time.Duration // how often movie_stats popularity is recomputed
+}
+
+var DefaultTrendingConfig = TrendingConfig{
+	BufferSize:      10000,
+	BatchSize:       500,
+	FlushInterval:   5 * time.Second,
+	RefreshInterval: 10 * time.Minute,
+}
+
+type Trending_Service interface {
+	// RecordView counts a view of the movie. It never blocks: views are
+	// written in batches by Run, and dropped while the queue is full.
+	RecordView(movieID string)
+	GetTrending(ctx context.Context, window, lang string, includeAdult bool, page, pageSize int) (model.DiscoverMoviesResponse, error)
+}
+
+type view struct {
+	movieID uuid.UUID
+	at      time.Time
+}
+
+// viewKey is the movie_views row a view is counted in.
+type viewKey struct {
+	movieID uuid.UUID
+	hour    time.Time
+}
+
+func (v view) key() viewKey {
+	return viewKey{movieID: v.movieID, hour: v.at.UTC().Truncate(time.Hour)}
+}
+
+type trending_service struct {
+	repo  viewrepo.ViewRepository
+	cfg   TrendingConfig
+	views chan view
+	now   func() time.Time
+}
+
+func New_Trending_Service(r viewrepo.ViewRepository, cfg TrendingConfig) *trending_service {
+	return &trending_service{repo: r, cfg: cfg, views: make(chan view, cfg.BufferSize), now: time.Now}
+}
+
+func (r *trending_service) RecordView(movieID string) {
+	id, err := uuid.FromString(movieID)
+	if err != nil {
+		return
+	}
+	select {
+	case r.views <- view{movieID: id, at: r.now()}:
+	default:
+	}
+}
+
+// Run writes recorded views in batches and refreshes popularity until ctx
+// is done, then writes what is still queued and returns.
+func (r *trending_service) Run(ctx context.Context) {
+	flush := time.NewTicker(r.cfg.FlushInterval)
+	defer flush.Stop()
+	refresh := time.NewTicker(r.cfg.RefreshInterval)
+	defer refresh.Stop()
+
+	pending := make(map[viewKey]int)
+	for {
+		select {
+		case v := <-r.views:
+			pending[v.key()]++
+			if len(pending) >= r.cfg.BatchSize {
+				pending = r.flush(ctx, pending)
+			}
+		case <-flush.C:
+			pending = r.flush(ctx, pending)
+		case <-refresh.C:
+			if _, err := r.RefreshPopularity(ctx); err != nil {
+				log.Println("trending: refresh popularity:", err)
+			}
+		case <-ctx.Done():
+			r.drain(pending)
+			r.flush(context.Background(), pending)
+			return
+		}
+	}
+}
+
+// drain adds the views still queued to pending.
+func (r *trending_service) drain(pending map[viewKey]int) {
+	for {
+		select {
+		case v := <-r.views:
+			pending[v.key()]++
+		default:
+			return
+		}
+	}
+}
+
+// flush writes pending and returns an empty batch. A batch that fails to
+// write is dropped rather than retried.
+func (r *trending_service) flush(ctx context.Context, pending map[viewKey]int) map[viewKey]int {
+	if len(pending) == 0 {
+		return pending
+	}
+	views := make([]model.MovieViews, 0, len(pending))
+	for k, n := range pending {
+		views = append(views, model.MovieViews{MovieID: k.movieID, Hour: k.hour, Views: n})
+	}
+	if err := r.repo.RecordViews(ctx, views); err != nil {
+		log.Println("trending: record views:", err)
+	}
+	return make(map[viewKey]int)
+}
+
+// RefreshPopularity recomputes movie_stats popularity from the week's views
+// and returns the number of movies whose popularity changed.
+func (r *trending_service) RefreshPopularity(ctx context.Context) (int, error) {
+	n, err := r.repo.RefreshPopularity(ctx, r.window(TrendingWindows[popularityWindow]))
+	if err != nil {
+		return 0, fmt.Errorf("service: RefreshPopularity: %w", err)
+	}
+	return n, nil
+}
+
+func (r *trending_service) GetTrending(ctx context.Context, window, lang string, includeAdult bool, page, pageSize int) (model.DiscoverMoviesResponse, error) {
+	tw, ok := TrendingWindows[window]
+	if !ok {
+		verr := &model.ValidationError{}
+		verr.Add("time_window", "must be day or week")
+		return model.DiscoverMoviesResponse{}, verr
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	movies, total, err := r.repo.FetchTrending(ctx, r.window(tw), lang, includeAdult, page, pageSize)
+	if err != nil {
+		return model.DiscoverMoviesResponse{}, fmt.Errorf("service: FetchTrending: %w", err)
+	}
+
+	res := model.DiscoverMoviesResponse{
+		Page:         page,
+		PageSize:     pageSize,
+		TotalResults: total,
+		Results:      movies,
+	}
+	if total > 0 {
+		res.TotalPages = int(math.Ceil(float64(total) / float64(pageSize)))
+	}
+	return res, nil
+}
+
+// window is tw ending now, starting on the hour so it covers whole hours.
+func (r *trending_service) window(tw TrendingWindow) viewrepo.Window {
+	now := r.now()
+	return viewrepo.Window{
+		Since:    now.Add(-tw.Span).UTC().Truncate(time.Hour),
+		Now:      now,
+		HalfLife: tw.HalfLife,
+	}
+}
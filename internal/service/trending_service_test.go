@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/fixtures"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	memoryrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/memory_repo"
+)
+
+// recordViews records views of each movie at now, and runs the service
+// until they are written.
+func recordViews(t *testing.T, svc *trending_service, now time.Time, views map[string]int) {
+	t.Helper()
+	svc.now = func() time.Time { return now }
+	for id, n := range views {
+		for range n {
+			svc.RecordView(id)
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	svc.Run(ctx)
+}
+
+func TestGetTrending(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	svc := New_Trending_Service(mem, DefaultTrendingConfig)
+	ctx := context.Background()
+	now := time.Date(2026, 5, 1, 12, 30, 0, 0, time.UTC)
+	inception, darkKnight, interstellar := ds.Movies[0].ID, ds.Movies[1].ID, ds.Movies[2].ID
+
+	// Inception was big three days ago, The Dark Knight is big today
+	recordViews(t, svc, now.Add(-72*time.Hour), map[string]int{inception.String(): 10, interstellar.String(): 1})
+	recordViews(t, svc, now, map[string]int{darkKnight.String(): 3, "not-a-uuid": 5})
+
+	day, err := svc.GetTrending(ctx, "day", "en", false, 1, 20)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if day.TotalResults != 1 || day.Results[0].ID != darkKnight {
+		t.Errorf("expected only today's views in the day window, got %+v", day.Results)
+	}
+
+	week, _ := svc.GetTrending(ctx, "week", "en", false, 1, 2)
+	if week.TotalResults != 3 || week.TotalPages != 2 {
+		t.Fatalf("expected 3 movies over 2 pages, got %d over %d", week.TotalResults, week.TotalPages)
+	}
+	// 10 views decayed over 1.5 half-lives still beat 3 fresh ones
+	if week.Results[0].ID != inception || week.Results[1].ID != darkKnight {
+		t.Errorf("expected Inception then The Dark Knight, got %s, %s", week.Results[0].Title, week.Results[1].Title)
+	}
+
+	var verr *model.ValidationError
+	if _, err := svc.GetTrending(ctx, "month", "en", false, 1, 20); !errors.As(err, &verr) {
+		t.Errorf("expected a validation error for an unknown window, got %v", err)
+	}
+}
+
+func TestRefreshPopularity(t *testing.T) {
+	ds := fixtures.Build(time.Now())
+	mem := memoryrepo.New_Memory_Repo(ds)
+	svc := New_Trending_Service(mem, DefaultTrendingConfig)
+	movies := New_Movie_Service(mem)
+	ctx := context.Background()
+	now := time.Now()
+	inception, untouched := ds.Movies[0], ds.Movies[1]
+
+	// discover's default popularity.desc order
+	discover := func() []model.DiscoverItem {
+		res, _ := movies.Discover(ctx, model.DiscoverMoviesParams{PageSize: 100})
+		return res.Results
+	}
+	popularity := func(id uuid.UUID) float64 {
+		for _, it := range discover() {
+			if it.ID == id {
+				return *it.Popularity
+			}
+		}
+		t.Fatalf("movie %s not discovered", id)
+		return 0
+	}
+	before := discover()
+
+	recordViews(t, svc, now, map[string]int{inception.ID.String(): 4})
+	if n, err := svc.RefreshPopularity(ctx); err != nil || n != 1 {
+		t.Fatalf("expected only the viewed movie updated, got %d, %v", n, err)
+	}
+
+	p := popularity(inception.ID)
+	if p <= inception.Popularity+3 || p > inception.Popularity+4 {
+		t.Errorf("expected the seeded popularity plus 4 fresh views, got %v", p)
+	}
+	if p := popularity(untouched.ID); p != untouched.Popularity {
+		t.Errorf("expected movies without views to keep their popularity, got %v", p)
+	}
+
+	// views only ever move a movie up: the others keep their order
+	rank := func(items []model.DiscoverItem, id uuid.UUID) int {
+		return slices.IndexFunc(items, func(it model.DiscoverItem) bool { return it.ID == id })
+	}
+	unviewed := func(items []model.DiscoverItem) []uuid.UUID {
+		var ids []uuid.UUID
+		for _, it := range items {
+			if it.ID != inception.ID {
+				ids = append(ids, it.ID)
+			}
+		}
+		return ids
+	}
+	after := discover()
+	for i := 1; i < len(after); i++ {
+		if *after[i].Popularity > *after[i-1].Popularity {
+			t.Errorf("expected discover by popularity, got %s below %s", after[i].Title, after[i-1].Title)
+		}
+	}
+	if !slices.Equal(unviewed(after), unviewed(before)) {
+		t.Error("expected unviewed movies to keep their order")
+	}
+	if rank(after, inception.ID) >= rank(before, inception.ID) {
+		t.Errorf("expected Inception to move up from %d, got %d", rank(before, inception.ID), rank(after, inception.ID))
+	}
+
+	// once the views age out of the window popularity is back to its base
+	svc.now = func() time.Time { return now.Add(8 * 24 * time.Hour) }
+	if n, _ := svc.RefreshPopularity(ctx); n != 1 {
+		t.Errorf("expected the aged-out movie reset, got %d updated", n)
+	}
+	if p := popularity(inception.ID); p != inception.Popularity {
+		t.Errorf("expected the seeded popularity after the window, got %v", p)
+	}
+	if rank(discover(), inception.ID) != rank(before, inception.ID) {
+		t.Error("expected Inception back in its seeded place")
+	}
+	if n, _ := svc.RefreshPopularity(ctx); n != 0 {
+		t.Errorf("expected nothing left to update, got %d", n)
+	}
+}
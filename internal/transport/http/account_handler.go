@@ -0,0 +1,118 @@
+package httptransport
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/h-raju-arch/movie_app_backend/internal/service"
+)
+
+type Account_handler struct {
+	svc service.Account_Service
+}
+
+func New_Account_Handler(svc service.Account_Service) *Account_handler {
+	return &Account_handler{svc: svc}
+}
+
+func (h Account_handler) SetWatchlist(c *gin.Context) {
+	var w model.WatchlistWrite
+	if err := c.ShouldBindJSON(&w); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid JSON body: " + err.Error()})
+		return
+	}
+	if w.Watchlist == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "watchlist (true or false) is required"})
+		return
+	}
+	h.setListed(c, "SetWatchlist", w.MovieID, *w.Watchlist, h.svc.SetWatchlist)
+}
+
+func (h Account_handler) SetFavorite(c *gin.Context) {
+	var w model.FavoriteWrite
+	if err := c.ShouldBindJSON(&w); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid JSON body: " + err.Error()})
+		return
+	}
+	if w.Favorite == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "favorite (true or false) is required"})
+		return
+	}
+	h.setListed(c, "SetFavorite", w.MovieID, *w.Favorite, h.svc.SetFavorite)
+}
+
+type setListedFunc func(ctx context.Context, userID uuid.UUID, movieID string, on bool) (model.AccountStates, error)
+
+func (h Account_handler) setListed(c *gin.Context, op, movieID string, on bool, set setListedFunc) {
+	userID, _ := UserID(c)
+
+	if _, err := uuid.FromString(movieID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid movie ID"})
+		return
+	}
+
+	res, err := set(c.Request.Context(), userID, movieID, on)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Movie not found"})
+		return
+	}
+	if err != nil {
+		fmt.Println(op, "error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h Account_handler) GetWatchlist(c *gin.Context) {
+	h.getListed(c, "GetWatchlist", h.svc.GetWatchlist)
+}
+
+func (h Account_handler) GetFavorites(c *gin.Context) {
+	h.getListed(c, "GetFavorites", h.svc.GetFavorites)
+}
+
+type getListedFunc func(ctx context.Context, userID uuid.UUID, lang string, page, pageSize int) (model.DiscoverMoviesResponse, error)
+
+func (h Account_handler) getListed(c *gin.Context, op string, get getListedFunc) {
+	userID, _ := UserID(c)
+
+	lang := c.DefaultQuery("language", "en")
+	page, pageSize, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	res, err := get(c.Request.Context(), userID, lang, page, pageSize)
+	if err != nil {
+		fmt.Println(op, "error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// pageParams parses the page and page_size of a paginated list, answering
+// 400 itself when either is invalid.
+func pageParams(c *gin.Context) (int, int, bool) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page (must be >= 1)"})
+		return 0, 0, false
+	}
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	if err != nil || pageSize < 1 || pageSize > 100 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page_size (1-100)"})
+		return 0, 0, false
+	}
+	return page, pageSize, true
+}
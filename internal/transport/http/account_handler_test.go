@@ -0,0 +1,183 @@
+package httptransport
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/auth"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// MockAccountService is a manual mock implementation of Account_Service
+type MockAccountService struct {
+	SetWatchlistFunc func(ctx context.Context, userID uuid.UUID, movieID string, on bool) (model.AccountStates, error)
+	SetFavoriteFunc  func(ctx context.Context, userID uuid.UUID, movieID string, on bool) (model.AccountStates, error)
+	GetWatchlistFunc func(ctx context.Context, userID uuid.UUID, lang string, page, pageSize int) (model.DiscoverMoviesResponse, error)
+	GetFavoritesFunc func(ctx context.Context, userID uuid.UUID, lang string, page, pageSize int) (model.DiscoverMoviesResponse, error)
+}
+
+func (m *MockAccountService) SetWatchlist(ctx context.Context, userID uuid.UUID, movieID string, on bool) (model.AccountStates, error) {
+	if m.SetWatchlistFunc != nil {
+		return m.SetWatchlistFunc(ctx, userID, movieID, on)
+	}
+	return model.AccountStates{}, nil
+}
+
+func (m *MockAccountService) SetFavorite(ctx context.Context, userID uuid.UUID, movieID string, on bool) (model.AccountStates, error) {
+	if m.SetFavoriteFunc != nil {
+		return m.SetFavoriteFunc(ctx, userID, movieID, on)
+	}
+	return model.AccountStates{}, nil
+}
+
+func (m *MockAccountService) GetWatchlist(ctx context.Context, userID uuid.UUID, lang string, page, pageSize int) (model.DiscoverMoviesResponse, error) {
+	if m.GetWatchlistFunc != nil {
+		return m.GetWatchlistFunc(ctx, userID, lang, page, pageSize)
+	}
+	return model.DiscoverMoviesResponse{}, nil
+}
+
+func (m *MockAccountService) GetFavorites(ctx context.Context, userID uuid.UUID, lang string, page, pageSize int) (model.DiscoverMoviesResponse, error) {
+	if m.GetFavoritesFunc != nil {
+		return m.GetFavoritesFunc(ctx, userID, lang, page, pageSize)
+	}
+	return model.DiscoverMoviesResponse{}, nil
+}
+
+func setupAccountRouter(handler *Account_handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	account := r.Group("/account", TrustedUserHeader(testUserHeader))
+	account.POST("/watchlist", handler.SetWatchlist)
+	account.GET("/watchlist/movies", handler.GetWatchlist)
+	account.POST("/favorite", handler.SetFavorite)
+	account.GET("/favorite/movies", handler.GetFavorites)
+	return r
+}
+
+func TestSetWatchlist_Handler(t *testing.T) {
+	userID := uuid.Must(uuid.NewV7())
+	movieID := uuid.Must(uuid.NewV7()).String()
+
+	tests := []struct {
+		name string
+		body string
+		err  error
+		want int
+	}{
+		{"add", `{"movie_id": "` + movieID + `", "watchlist": true}`, nil, http.StatusOK},
+		{"remove", `{"movie_id": "` + movieID + `", "watchlist": false}`, nil, http.StatusOK},
+		{"unknown movie", `{"movie_id": "` + movieID + `", "watchlist": true}`, fmt.Errorf("service: SetListed: %w", sql.ErrNoRows), http.StatusNotFound},
+		{"missing flag", `{"movie_id": "` + movieID + `"}`, nil, http.StatusBadRequest},
+		{"invalid id", `{"movie_id": "abc", "watchlist": true}`, nil, http.StatusBadRequest},
+		{"invalid body", `{"watchlist": "yes"}`, nil, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := &MockAccountService{
+				SetWatchlistFunc: func(ctx context.Context, gotUser uuid.UUID, gotMovie string, on bool) (model.AccountStates, error) {
+					if gotUser != userID || gotMovie != movieID {
+						t.Errorf("unexpected call for %s on %s", gotUser, gotMovie)
+					}
+					return model.AccountStates{Watchlist: on}, tt.err
+				},
+			}
+			router := setupAccountRouter(New_Account_Handler(mockSvc))
+
+			req, _ := http.NewRequest("POST", "/account/watchlist", strings.NewReader(tt.body))
+			req.Header.Set(testUserHeader, userID.String())
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestGetFavorites_Handler(t *testing.T) {
+	userID := uuid.Must(uuid.NewV7())
+	mockSvc := &MockAccountService{
+		GetFavoritesFunc: func(ctx context.Context, gotUser uuid.UUID, lang string, page, pageSize int) (model.DiscoverMoviesResponse, error) {
+			if gotUser != userID || lang != "ja" || page != 3 || pageSize != 10 {
+				t.Errorf("unexpected call %s %s %d %d", gotUser, lang, page, pageSize)
+			}
+			return model.DiscoverMoviesResponse{Page: page, Results: []model.DiscoverItem{{Title: "Spirited Away"}}}, nil
+		},
+	}
+	router := setupAccountRouter(New_Account_Handler(mockSvc))
+
+	for query, want := range map[string]int{
+		"?language=ja&page=3&page_size=10": http.StatusOK,
+		"?page=0":                          http.StatusBadRequest,
+		"?page_size=101":                   http.StatusBadRequest,
+	} {
+		req, _ := http.NewRequest("GET", "/account/favorite/movies"+query, nil)
+		req.Header.Set(testUserHeader, userID.String())
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != want {
+			t.Errorf("%s: expected status %d, got %d", query, want, w.Code)
+		}
+	}
+}
+
+func TestOptionalUserAuth(t *testing.T) {
+	userID := uuid.Must(uuid.NewV7())
+	mockAuth := &MockAuthService{
+		AuthenticateFunc: func(accessToken string) (uuid.UUID, error) {
+			if accessToken != "valid" {
+				return uuid.Nil, auth.ErrInvalidToken
+			}
+			return userID, nil
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/movie/", OptionalUserAuth(mockAuth), func(c *gin.Context) {
+		id, _ := auth.UserID(c.Request.Context())
+		c.JSON(http.StatusOK, gin.H{"user_id": id})
+	})
+
+	for header, want := range map[string]struct {
+		code int
+		user uuid.UUID
+	}{
+		"":               {http.StatusOK, uuid.Nil},
+		"Bearer valid":   {http.StatusOK, userID},
+		"Bearer invalid": {http.StatusUnauthorized, uuid.Nil},
+	} {
+		req, _ := http.NewRequest("GET", "/movie/", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != want.code {
+			t.Errorf("%q: expected status %d, got %d", header, want.code, w.Code)
+			continue
+		}
+		if want.code == http.StatusOK {
+			var response struct {
+				UserID uuid.UUID `json:"user_id"`
+			}
+			json.Unmarshal(w.Body.Bytes(), &response)
+			if response.UserID != want.user {
+				t.Errorf("%q: expected user %s, got %s", header, want.user, response.UserID)
+			}
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package httptransport
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminTokenHeader carries the shared secret the admin API is protected by.
+const AdminTokenHeader = "X-Admin-Token"
+
+// AdminAuth rejects requests whose X-Admin-Token header doesn't match token.
+// An empty token disables the admin API altogether.
+func AdminAuth(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		got := c.GetHeader(AdminTokenHeader)
+		if token == "" || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid admin token"})
+			return
+		}
+		c.Next()
+	}
+}
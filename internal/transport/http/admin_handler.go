@@ -0,0 +1,159 @@
+package httptransport
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/h-raju-arch/movie_app_backend/internal/service"
+)
+
+type Admin_handler struct {
+	svc service.Admin_Service
+}
+
+func New_Admin_Handler(svc service.Admin_Service) *Admin_handler {
+	return &Admin_handler{svc: svc}
+}
+
+func (h Admin_handler) CreateMovie(c *gin.Context) {
+
+	ctx := c.Request.Context()
+
+	var w model.MovieWrite
+	if err := c.ShouldBindJSON(&w); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid JSON body: " + err.Error()})
+		return
+	}
+
+	res, err := h.svc.CreateMovie(ctx, w)
+	if err != nil {
+		writeAdminError(c, "CreateMovie", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h Admin_handler) ReplaceMovie(c *gin.Context) {
+	h.updateMovie(c, "ReplaceMovie", h.svc.ReplaceMovie)
+}
+
+func (h Admin_handler) PatchMovie(c *gin.Context) {
+	h.updateMovie(c, "PatchMovie", h.svc.PatchMovie)
+}
+
+func (h Admin_handler) updateMovie(c *gin.Context, op string, update func(ctx context.Context, id string, w model.MovieWrite) (model.MovieResponse, error)) {
+
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	if _, err := uuid.FromString(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid movie ID"})
+		return
+	}
+
+	var w model.MovieWrite
+	if err := c.ShouldBindJSON(&w); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid JSON body: " + err.Error()})
+		return
+	}
+
+	res, err := update(ctx, id, w)
+	if err != nil {
+		writeAdminError(c, op, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h Admin_handler) DeleteMovie(c *gin.Context) {
+
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	if _, err := uuid.FromString(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid movie ID"})
+		return
+	}
+
+	if err := h.svc.DeleteMovie(ctx, id); err != nil {
+		writeAdminError(c, "DeleteMovie", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h Admin_handler) PutTranslation(c *gin.Context) {
+
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	lang := c.Param("lang")
+
+	if _, err := uuid.FromString(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid movie ID"})
+		return
+	}
+
+	var t model.TranslationWrite
+	if err := c.ShouldBindJSON(&t); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid JSON body: " + err.Error()})
+		return
+	}
+
+	res, created, err := h.svc.PutTranslation(ctx, id, lang, t)
+	if err != nil {
+		writeAdminError(c, "PutTranslation", err)
+		return
+	}
+
+	if created {
+		c.JSON(http.StatusCreated, res)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func (h Admin_handler) DeleteTranslation(c *gin.Context) {
+
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	if _, err := uuid.FromString(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid movie ID"})
+		return
+	}
+
+	if err := h.svc.DeleteTranslation(ctx, id, c.Param("lang")); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Translation not found"})
+			return
+		}
+		writeAdminError(c, "DeleteTranslation", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// writeAdminError maps write errors: validation failures are 422, unknown
+// movies 404, anything else 500.
+func writeAdminError(c *gin.Context, op string, err error) {
+	var verr *model.ValidationError
+	switch {
+	case errors.As(err, &verr):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": "validation failed", "errors": verr.Errors})
+	case errors.Is(err, sql.ErrNoRows):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Movie not found"})
+	default:
+		fmt.Println(op+" error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
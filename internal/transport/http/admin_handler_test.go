@@ -0,0 +1,278 @@
+package httptransport
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// MockAdminService is a manual mock implementation of Admin_Service
+type MockAdminService struct {
+	CreateMovieFunc  func(ctx context.Context, w model.MovieWrite) (model.MovieResponse, error)
+	ReplaceMovieFunc func(ctx context.Context, id string, w model.MovieWrite) (model.MovieResponse, error)
+	PatchMovieFunc   func(ctx context.Context, id string, w model.MovieWrite) (model.MovieResponse, error)
+	DeleteMovieFunc  func(ctx context.Context, id string) error
+
+	PutTranslationFunc    func(ctx context.Context, id, lang string, t model.TranslationWrite) (model.MovieTranslation, bool, error)
+	DeleteTranslationFunc func(ctx context.Context, id, lang string) error
+}
+
+func (m *MockAdminService) CreateMovie(ctx context.Context, w model.MovieWrite) (model.MovieResponse, error) {
+	if m.CreateMovieFunc != nil {
+		return m.CreateMovieFunc(ctx, w)
+	}
+	return model.MovieResponse{}, nil
+}
+
+func (m *MockAdminService) ReplaceMovie(ctx context.Context, id string, w model.MovieWrite) (model.MovieResponse, error) {
+	if m.ReplaceMovieFunc != nil {
+		return m.ReplaceMovieFunc(ctx, id, w)
+	}
+	return model.MovieResponse{}, nil
+}
+
+func (m *MockAdminService) PatchMovie(ctx context.Context, id string, w model.MovieWrite) (model.MovieResponse, error) {
+	if m.PatchMovieFunc != nil {
+		return m.PatchMovieFunc(ctx, id, w)
+	}
+	return model.MovieResponse{}, nil
+}
+
+func (m *MockAdminService) DeleteMovie(ctx context.Context, id string) error {
+	if m.DeleteMovieFunc != nil {
+		return m.DeleteMovieFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockAdminService) PutTranslation(ctx context.Context, id, lang string, t model.TranslationWrite) (model.MovieTranslation, bool, error) {
+	if m.PutTranslationFunc != nil {
+		return m.PutTranslationFunc(ctx, id, lang, t)
+	}
+	return model.MovieTranslation{}, false, nil
+}
+
+func (m *MockAdminService) DeleteTranslation(ctx context.Context, id, lang string) error {
+	if m.DeleteTranslationFunc != nil {
+		return m.DeleteTranslationFunc(ctx, id, lang)
+	}
+	return nil
+}
+
+const testAdminToken = "secret"
+
+func setupAdminRouter(handler *Admin_handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	admin := r.Group("/admin", AdminAuth(testAdminToken))
+	admin.POST("/movies", handler.CreateMovie)
+	admin.PUT("/movies/:id", handler.ReplaceMovie)
+	admin.PATCH("/movies/:id", handler.PatchMovie)
+	admin.DELETE("/movies/:id", handler.DeleteMovie)
+	admin.PUT("/movies/:id/translations/:lang", handler.PutTranslation)
+	admin.DELETE("/movies/:id/translations/:lang", handler.DeleteTranslation)
+	return r
+}
+
+func adminRequest(method, path, body string) *http.Request {
+	req, _ := http.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(AdminTokenHeader, testAdminToken)
+	return req
+}
+
+func TestAdmin_Unauthorized(t *testing.T) {
+	called := false
+	mockSvc := &MockAdminService{
+		DeleteMovieFunc: func(ctx context.Context, id string) error {
+			called = true
+			return nil
+		},
+	}
+	router := setupAdminRouter(New_Admin_Handler(mockSvc))
+
+	for _, token := range []string{"", "wrong"} {
+		req := adminRequest("DELETE", "/admin/movies/"+uuid.Must(uuid.NewV4()).String(), "")
+		req.Header.Set(AdminTokenHeader, token)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("token %q: expected status %d, got %d", token, http.StatusUnauthorized, w.Code)
+		}
+	}
+	if called {
+		t.Error("expected the service not to be called")
+	}
+}
+
+func TestAdminAuth_EmptyTokenDisables(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/admin", AdminAuth(""), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	req, _ := http.NewRequest("GET", "/admin", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+}
+
+func TestCreateMovie_Success(t *testing.T) {
+	movieID := uuid.Must(uuid.NewV4())
+	mockSvc := &MockAdminService{
+		CreateMovieFunc: func(ctx context.Context, w model.MovieWrite) (model.MovieResponse, error) {
+			if w.Title == nil || *w.Title != "New" || w.GenreIDs == nil || len(*w.GenreIDs) != 1 {
+				t.Errorf("unexpected body %+v", w)
+			}
+			return model.MovieResponse{ID: movieID, Title: *w.Title}, nil
+		},
+	}
+	router := setupAdminRouter(New_Admin_Handler(mockSvc))
+
+	body := fmt.Sprintf(`{"title": "New", "genre_ids": [%q]}`, uuid.Must(uuid.NewV4()))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, adminRequest("POST", "/admin/movies", body))
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+	var response model.MovieResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	if response.ID != movieID {
+		t.Errorf("expected id %s, got %s", movieID, response.ID)
+	}
+}
+
+func TestCreateMovie_InvalidJSON(t *testing.T) {
+	router := setupAdminRouter(New_Admin_Handler(&MockAdminService{}))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, adminRequest("POST", "/admin/movies", `{"genre_ids": ["not-a-uuid"]}`))
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestPatchMovie_ValidationError(t *testing.T) {
+	mockSvc := &MockAdminService{
+		PatchMovieFunc: func(ctx context.Context, id string, w model.MovieWrite) (model.MovieResponse, error) {
+			verr := &model.ValidationError{}
+			verr.Add("release_date", "must be a date formatted as YYYY-MM-DD")
+			return model.MovieResponse{}, verr
+		},
+	}
+	router := setupAdminRouter(New_Admin_Handler(mockSvc))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, adminRequest("PATCH", "/admin/movies/"+uuid.Must(uuid.NewV4()).String(), `{"release_date": "soon"}`))
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
+	}
+	var response struct {
+		Errors []model.FieldError `json:"errors"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &response)
+	if len(response.Errors) != 1 || response.Errors[0].Field != "release_date" {
+		t.Errorf("expected the field errors in the body, got %s", w.Body.String())
+	}
+}
+
+func TestReplaceMovie_NotFound(t *testing.T) {
+	mockSvc := &MockAdminService{
+		ReplaceMovieFunc: func(ctx context.Context, id string, w model.MovieWrite) (model.MovieResponse, error) {
+			return model.MovieResponse{}, fmt.Errorf("service: ReplaceMovie: %w", sql.ErrNoRows)
+		},
+	}
+	router := setupAdminRouter(New_Admin_Handler(mockSvc))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, adminRequest("PUT", "/admin/movies/"+uuid.Must(uuid.NewV4()).String(), `{"title": "x"}`))
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestDeleteMovie_Success(t *testing.T) {
+	router := setupAdminRouter(New_Admin_Handler(&MockAdminService{}))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, adminRequest("DELETE", "/admin/movies/"+uuid.Must(uuid.NewV4()).String(), ""))
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d", http.StatusNoContent, w.Code)
+	}
+}
+
+func TestPutTranslation_CreatedAndUpdated(t *testing.T) {
+	for _, created := range []bool{true, false} {
+		mockSvc := &MockAdminService{
+			PutTranslationFunc: func(ctx context.Context, id, lang string, tw model.TranslationWrite) (model.MovieTranslation, bool, error) {
+				if lang != "de" || tw.Title == nil || *tw.Title != "Der Film" {
+					t.Errorf("unexpected lang %s and body %+v", lang, tw)
+				}
+				return model.MovieTranslation{ISO6391: lang, Data: model.TranslationData{Title: tw.Title}}, created, nil
+			},
+		}
+		router := setupAdminRouter(New_Admin_Handler(mockSvc))
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, adminRequest("PUT", "/admin/movies/"+uuid.Must(uuid.NewV4()).String()+"/translations/de", `{"title": "Der Film"}`))
+
+		want := http.StatusOK
+		if created {
+			want = http.StatusCreated
+		}
+		if w.Code != want {
+			t.Errorf("created %v: expected status %d, got %d", created, want, w.Code)
+		}
+	}
+}
+
+func TestPutTranslation_UnknownLanguage(t *testing.T) {
+	mockSvc := &MockAdminService{
+		PutTranslationFunc: func(ctx context.Context, id, lang string, tw model.TranslationWrite) (model.MovieTranslation, bool, error) {
+			verr := &model.ValidationError{}
+			verr.Add("lang", "is not a known language")
+			return model.MovieTranslation{}, false, verr
+		},
+	}
+	router := setupAdminRouter(New_Admin_Handler(mockSvc))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, adminRequest("PUT", "/admin/movies/"+uuid.Must(uuid.NewV4()).String()+"/translations/xx", `{"title": "x"}`))
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
+	}
+}
+
+func TestDeleteTranslation_NotFound(t *testing.T) {
+	mockSvc := &MockAdminService{
+		DeleteTranslationFunc: func(ctx context.Context, id, lang string) error {
+			return fmt.Errorf("service: DeleteTranslation: %w", sql.ErrNoRows)
+		},
+	}
+	router := setupAdminRouter(New_Admin_Handler(mockSvc))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, adminRequest("DELETE", "/admin/movies/"+uuid.Must(uuid.NewV4()).String()+"/translations/de", ""))
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -0,0 +1,115 @@
+package httptransport
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/h-raju-arch/movie_app_backend/internal/auth"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	userrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/user_repo"
+	"github.com/h-raju-arch/movie_app_backend/internal/service"
+)
+
+type Auth_handler struct {
+	svc service.Auth_Service
+}
+
+func New_Auth_Handler(svc service.Auth_Service) *Auth_handler {
+	return &Auth_handler{svc: svc}
+}
+
+func (h Auth_handler) Register(c *gin.Context) {
+
+	ctx := c.Request.Context()
+
+	var creds model.Credentials
+	if err := c.ShouldBindJSON(&creds); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid JSON body: " + err.Error()})
+		return
+	}
+
+	res, err := h.svc.Register(ctx, creds)
+	var verr *model.ValidationError
+	switch {
+	case err == nil:
+		c.JSON(http.StatusCreated, res)
+	case errors.As(err, &verr):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": "validation failed", "errors": verr.Errors})
+	case errors.Is(err, userrepo.ErrEmailTaken):
+		c.JSON(http.StatusConflict, gin.H{"error": "Email already registered"})
+	default:
+		fmt.Println("Register error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+func (h Auth_handler) Login(c *gin.Context) {
+
+	ctx := c.Request.Context()
+
+	var creds model.Credentials
+	if err := c.ShouldBindJSON(&creds); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid JSON body: " + err.Error()})
+		return
+	}
+
+	res, err := h.svc.Login(ctx, creds)
+	if errors.Is(err, service.ErrInvalidCredentials) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
+		return
+	}
+	if err != nil {
+		fmt.Println("Login error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h Auth_handler) Refresh(c *gin.Context) {
+
+	ctx := c.Request.Context()
+
+	var req model.RefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.RefreshToken == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "refresh_token is required"})
+		return
+	}
+
+	res, err := h.svc.Refresh(ctx, req.RefreshToken)
+	if errors.Is(err, auth.ErrInvalidToken) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
+		return
+	}
+	if err != nil {
+		fmt.Println("Refresh error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetAccount returns the signed-in user; it runs behind UserAuth.
+func (h Auth_handler) GetAccount(c *gin.Context) {
+
+	ctx := c.Request.Context()
+	userID, _ := UserID(c)
+
+	res, err := h.svc.GetAccount(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Account not found"})
+		return
+	}
+	if err != nil {
+		fmt.Println("GetAccount error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
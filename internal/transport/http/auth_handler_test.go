@@ -0,0 +1,201 @@
+package httptransport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/auth"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	userrepo "github.com/h-raju-arch/movie_app_backend/internal/repo/user_repo"
+	"github.com/h-raju-arch/movie_app_backend/internal/service"
+)
+
+// MockAuthService is a manual mock implementation of Auth_Service
+type MockAuthService struct {
+	RegisterFunc     func(ctx context.Context, creds model.Credentials) (model.AuthResponse, error)
+	LoginFunc        func(ctx context.Context, creds model.Credentials) (model.AuthResponse, error)
+	RefreshFunc      func(ctx context.Context, refreshToken string) (model.TokenPair, error)
+	AuthenticateFunc func(accessToken string) (uuid.UUID, error)
+	GetAccountFunc   func(ctx context.Context, userID uuid.UUID) (model.User, error)
+}
+
+func (m *MockAuthService) Register(ctx context.Context, creds model.Credentials) (model.AuthResponse, error) {
+	if m.RegisterFunc != nil {
+		return m.RegisterFunc(ctx, creds)
+	}
+	return model.AuthResponse{}, nil
+}
+
+func (m *MockAuthService) Login(ctx context.Context, creds model.Credentials) (model.AuthResponse, error) {
+	if m.LoginFunc != nil {
+		return m.LoginFunc(ctx, creds)
+	}
+	return model.AuthResponse{}, nil
+}
+
+func (m *MockAuthService) Refresh(ctx context.Context, refreshToken string) (model.TokenPair, error) {
+	if m.RefreshFunc != nil {
+		return m.RefreshFunc(ctx, refreshToken)
+	}
+	return model.TokenPair{}, nil
+}
+
+func (m *MockAuthService) Authenticate(accessToken string) (uuid.UUID, error) {
+	if m.AuthenticateFunc != nil {
+		return m.AuthenticateFunc(accessToken)
+	}
+	return uuid.Nil, auth.ErrInvalidToken
+}
+
+func (m *MockAuthService) GetAccount(ctx context.Context, userID uuid.UUID) (model.User, error) {
+	if m.GetAccountFunc != nil {
+		return m.GetAccountFunc(ctx, userID)
+	}
+	return model.User{}, nil
+}
+
+func setupAuthRouter(svc service.Auth_Service) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	h := New_Auth_Handler(svc)
+	r.POST("/auth/register", h.Register)
+	r.POST("/auth/login", h.Login)
+	r.POST("/auth/refresh", h.Refresh)
+	r.GET("/account", UserAuth(svc), h.GetAccount)
+	return r
+}
+
+func postJSON(router *gin.Engine, path, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("POST", path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestRegister_StatusCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"created", nil, http.StatusCreated},
+		{"invalid", &model.ValidationError{Errors: []model.FieldError{{Field: "email", Message: "must be a valid email address"}}}, http.StatusUnprocessableEntity},
+		{"taken", fmt.Errorf("service: CreateUser: %w", userrepo.ErrEmailTaken), http.StatusConflict},
+		{"failure", errors.New("database error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := &MockAuthService{
+				RegisterFunc: func(ctx context.Context, creds model.Credentials) (model.AuthResponse, error) {
+					return model.AuthResponse{User: model.User{Email: creds.Email, PasswordHash: "hash"}}, tt.err
+				},
+			}
+			w := postJSON(setupAuthRouter(mockSvc), "/auth/register", `{"email": "ada@example.com", "password": "correct horse"}`)
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+			if strings.Contains(w.Body.String(), "hash") {
+				t.Errorf("expected the password hash to stay private, got %s", w.Body.String())
+			}
+		})
+	}
+
+	w := postJSON(setupAuthRouter(&MockAuthService{}), "/auth/register", `{"email": `)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d for invalid JSON, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestLogin_InvalidCredentials(t *testing.T) {
+	mockSvc := &MockAuthService{
+		LoginFunc: func(ctx context.Context, creds model.Credentials) (model.AuthResponse, error) {
+			return model.AuthResponse{}, service.ErrInvalidCredentials
+		},
+	}
+
+	w := postJSON(setupAuthRouter(mockSvc), "/auth/login", `{"email": "ada@example.com", "password": "wrong"}`)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+}
+
+func TestRefresh_Handler(t *testing.T) {
+	mockSvc := &MockAuthService{
+		RefreshFunc: func(ctx context.Context, refreshToken string) (model.TokenPair, error) {
+			if refreshToken != "good" {
+				return model.TokenPair{}, auth.ErrInvalidToken
+			}
+			return model.TokenPair{AccessToken: "new", TokenType: "Bearer"}, nil
+		},
+	}
+	router := setupAuthRouter(mockSvc)
+
+	if w := postJSON(router, "/auth/refresh", `{"refresh_token": "good"}`); w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"access_token":"new"`) {
+		t.Errorf("expected a new pair, got %d %s", w.Code, w.Body.String())
+	}
+	if w := postJSON(router, "/auth/refresh", `{"refresh_token": "bad"}`); w.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+	if w := postJSON(router, "/auth/refresh", `{}`); w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestUserAuth(t *testing.T) {
+	userID := uuid.Must(uuid.NewV7())
+	var ctxUserID uuid.UUID
+	mockSvc := &MockAuthService{
+		AuthenticateFunc: func(accessToken string) (uuid.UUID, error) {
+			if accessToken != "valid" {
+				return uuid.Nil, auth.ErrInvalidToken
+			}
+			return userID, nil
+		},
+		GetAccountFunc: func(ctx context.Context, id uuid.UUID) (model.User, error) {
+			ctxUserID, _ = auth.UserID(ctx)
+			return model.User{ID: id, Email: "ada@example.com"}, nil
+		},
+	}
+	router := setupAuthRouter(mockSvc)
+
+	for header, want := range map[string]int{
+		"":               http.StatusUnauthorized,
+		"Basic valid":    http.StatusUnauthorized,
+		"Bearer invalid": http.StatusUnauthorized,
+		"Bearer valid":   http.StatusOK,
+		"bearer valid":   http.StatusOK,
+	} {
+		req, _ := http.NewRequest("GET", "/account", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != want {
+			t.Errorf("%q: expected status %d, got %d", header, want, w.Code)
+		}
+		if want == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") == "" {
+			t.Errorf("%q: expected a WWW-Authenticate challenge", header)
+		}
+		if want == http.StatusOK {
+			var response model.User
+			json.Unmarshal(w.Body.Bytes(), &response)
+			if response.ID != userID || ctxUserID != userID {
+				t.Errorf("%q: expected user %s in the response and request context, got %s and %s", header, userID, response.ID, ctxUserID)
+			}
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package httptransport
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/h-raju-arch/movie_app_backend/internal/service"
+)
+
+type Cache_handler struct {
+	svc service.Cache_Stats
+}
+
+// New_Cache_Handler serves the response cache's stats. svc is nil when
+// responses aren't cached.
+func New_Cache_Handler(svc service.Cache_Stats) *Cache_handler {
+	return &Cache_handler{svc: svc}
+}
+
+func (h Cache_handler) GetCacheStats(c *gin.Context) {
+	if h.svc == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Response cache is disabled"})
+		return
+	}
+	c.JSON(http.StatusOK, h.svc.Stats())
+}
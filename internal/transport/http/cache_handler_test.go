@@ -0,0 +1,64 @@
+package httptransport
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/h-raju-arch/movie_app_backend/internal/service"
+)
+
+// MockCacheStats is a manual mock implementation of Cache_Stats
+type MockCacheStats struct {
+	StatsFunc func() map[string]service.CacheCounts
+}
+
+func (m *MockCacheStats) Stats() map[string]service.CacheCounts {
+	if m.StatsFunc != nil {
+		return m.StatsFunc()
+	}
+	return nil
+}
+
+func setupCacheRouter(handler *Cache_handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/admin/cache/stats", handler.GetCacheStats)
+	return r
+}
+
+func TestGetCacheStats(t *testing.T) {
+	mockSvc := &MockCacheStats{
+		StatsFunc: func() map[string]service.CacheCounts {
+			return map[string]service.CacheCounts{"GetMovieById": {Hits: 3, Misses: 1}}
+		},
+	}
+	router := setupCacheRouter(New_Cache_Handler(mockSvc))
+
+	req, _ := http.NewRequest("GET", "/admin/cache/stats", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+	var res map[string]service.CacheCounts
+	json.Unmarshal(w.Body.Bytes(), &res)
+	if res["GetMovieById"].Hits != 3 || res["GetMovieById"].Misses != 1 {
+		t.Errorf("expected the counts, got %s", w.Body.String())
+	}
+}
+
+func TestGetCacheStats_Disabled(t *testing.T) {
+	router := setupCacheRouter(New_Cache_Handler(nil))
+
+	req, _ := http.NewRequest("GET", "/admin/cache/stats", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", w.Code)
+	}
+}
@@ -0,0 +1,60 @@
+package httptransport
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/service"
+)
+
+type Company_handler struct {
+	svc service.Company_Service
+}
+
+func New_Company_Handler(svc service.Company_Service) *Company_handler {
+	return &Company_handler{svc: svc}
+}
+
+func (h Company_handler) GetCompany(c *gin.Context) {
+
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	if _, err := uuid.FromString(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	lang := c.DefaultQuery("language", "en")
+	includeAdultStr := c.DefaultQuery("include_adult", "false")
+	includeAdult := includeAdultStr == "true" || includeAdultStr == "1"
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page (must be >= 1)"})
+		return
+	}
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	if err != nil || pageSize < 1 || pageSize > 100 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page_size (1-100)"})
+		return
+	}
+
+	res, err := h.svc.GetCompanyById(ctx, id, lang, includeAdult, page, pageSize)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Company not found"})
+		return
+	}
+	if err != nil {
+		fmt.Println("GetCompany error:", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,90 @@
+package httptransport
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// MockCompanyService is a manual mock implementation of Company_Service
+type MockCompanyService struct {
+	GetCompanyByIdFunc func(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) (model.CompanyResponse, error)
+}
+
+func (m *MockCompanyService) GetCompanyById(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) (model.CompanyResponse, error) {
+	if m.GetCompanyByIdFunc != nil {
+		return m.GetCompanyByIdFunc(ctx, id, lang, includeAdult, page, pageSize)
+	}
+	return model.CompanyResponse{}, nil
+}
+
+func setupCompanyRouter(handler *Company_handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/company/:id", handler.GetCompany)
+	return r
+}
+
+func TestGetCompany_InvalidID(t *testing.T) {
+	router := setupCompanyRouter(New_Company_Handler(&MockCompanyService{}))
+
+	req, _ := http.NewRequest("GET", "/company/abc", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestGetCompany_Pagination(t *testing.T) {
+	mockSvc := &MockCompanyService{
+		GetCompanyByIdFunc: func(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) (model.CompanyResponse, error) {
+			if page != 3 || pageSize != 5 || !includeAdult {
+				t.Errorf("expected page 3, page_size 5 and include_adult, got %d, %d, %v", page, pageSize, includeAdult)
+			}
+			return model.CompanyResponse{Name: "A24"}, nil
+		},
+	}
+	router := setupCompanyRouter(New_Company_Handler(mockSvc))
+
+	req, _ := http.NewRequest("GET", "/company/"+uuid.Must(uuid.NewV4()).String()+"?page=3&page_size=5&include_adult=true", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	req, _ = http.NewRequest("GET", "/company/"+uuid.Must(uuid.NewV4()).String()+"?page_size=500", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestGetCompany_NotFound(t *testing.T) {
+	mockSvc := &MockCompanyService{
+		GetCompanyByIdFunc: func(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) (model.CompanyResponse, error) {
+			return model.CompanyResponse{}, fmt.Errorf("service: Get company: %w", sql.ErrNoRows)
+		},
+	}
+	router := setupCompanyRouter(New_Company_Handler(mockSvc))
+
+	req, _ := http.NewRequest("GET", "/company/"+uuid.Must(uuid.NewV4()).String(), nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
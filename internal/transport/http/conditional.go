@@ -0,0 +1,137 @@
+package httptransport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MaxAges are the Cache-Control max-ages of the routes served with
+// ConditionalGET. Zero means no-cache: clients may keep the response but
+// must revalidate it, with its ETag, before reusing it.
+type MaxAges struct {
+	Movie    time.Duration // /api/movie/
+	Search   time.Duration // /api/movies/search
+	Discover time.Duration // /api/movies/discover
+}
+
+var DefaultMaxAges = MaxAges{
+	Movie:    time.Minute,
+	Search:   30 * time.Second,
+	Discover: 30 * time.Second,
+}
+
+// lastModifiedKey is the gin context key a handler stores the time its
+// response was last modified under (see setLastModified).
+const lastModifiedKey = "lastModified"
+
+func setLastModified(c *gin.Context, t time.Time) {
+	if !t.IsZero() {
+		c.Set(lastModifiedKey, t)
+	}
+}
+
+// ConditionalGET buffers the route's 200 responses to send them with a
+// strong ETag (a hash of the body) and Cache-Control, and answers 304 Not
+// Modified instead when the request's If-None-Match holds the ETag. Private
+// responses may differ from user to user, so only the client may store
+// them.
+//
+// Last-Modified is only sent when the handler set it: lists have no single
+// modification time. If-Modified-Since is not honored: appended data such
+// as votes or reviews changes without touching movies.updated_at, so only
+// the ETag is a safe validator.
+func ConditionalGET(maxAge time.Duration, private bool) gin.HandlerFunc {
+	cacheControl := "public"
+	if private {
+		cacheControl = "private"
+	}
+	if maxAge > 0 {
+		cacheControl += ", max-age=" + strconv.Itoa(int(maxAge.Seconds()))
+	} else {
+		cacheControl += ", no-cache"
+	}
+
+	return func(c *gin.Context) {
+		w := &bufferedWriter{ResponseWriter: c.Writer, status: http.StatusOK}
+		c.Writer = w
+		c.Next()
+		c.Writer = w.ResponseWriter
+
+		if w.status != http.StatusOK {
+			w.ResponseWriter.WriteHeader(w.status)
+			w.ResponseWriter.Write(w.body.Bytes())
+			return
+		}
+
+		sum := sha256.Sum256(w.body.Bytes())
+		etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+		h := c.Writer.Header()
+		h.Set("ETag", etag)
+		h.Set("Cache-Control", cacheControl)
+		if t, ok := c.Get(lastModifiedKey); ok {
+			h.Set("Last-Modified", t.(time.Time).UTC().Format(http.TimeFormat))
+		}
+
+		if etagMatches(c.GetHeader("If-None-Match"), etag) {
+			h.Del("Content-Type")
+			c.Writer.WriteHeader(http.StatusNotModified)
+			c.Writer.WriteHeaderNow()
+			return
+		}
+		c.Writer.WriteHeader(http.StatusOK)
+		c.Writer.Write(w.body.Bytes())
+	}
+}
+
+// etagMatches reports whether an If-None-Match header value matches etag,
+// comparing weakly as RFC 9110 asks for If-None-Match.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedWriter holds back the status and body a handler writes.
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	w.status = code
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedWriter) Written() bool {
+	return w.body.Len() > 0
+}
@@ -0,0 +1,105 @@
+package httptransport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+func TestConditionalGET(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	body := "first"
+	router.GET("/list", ConditionalGET(30*time.Second, false), func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"body": body})
+	})
+	router.GET("/missing", ConditionalGET(30*time.Second, false), func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Movie not found"})
+	})
+
+	get := func(path, ifNoneMatch string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", path, nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("/list", "")
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || w.Body.String() != `{"body":"first"}` {
+		t.Fatalf("expected the body, got %d %s", w.Code, w.Body.String())
+	}
+	if len(etag) != 34 || etag[0] != '"' {
+		t.Errorf("expected a strong ETag, got %q", etag)
+	}
+	if cc := w.Header().Get("Cache-Control"); cc != "public, max-age=30" {
+		t.Errorf("unexpected Cache-Control %q", cc)
+	}
+	if lm := w.Header().Get("Last-Modified"); lm != "" {
+		t.Errorf("expected no Last-Modified unless the handler set one, got %q", lm)
+	}
+
+	for _, inm := range []string{etag, "W/" + etag, `"other", ` + etag, "*"} {
+		w := get("/list", inm)
+		if w.Code != http.StatusNotModified || w.Body.Len() != 0 || w.Header().Get("ETag") != etag {
+			t.Errorf("%s: expected an empty 304 with the ETag, got %d %q", inm, w.Code, w.Body.String())
+		}
+	}
+
+	body = "changed"
+	w = get("/list", etag)
+	if w.Code != http.StatusOK || w.Header().Get("ETag") == etag {
+		t.Errorf("expected a new body and ETag once it changed, got %d %q", w.Code, w.Header().Get("ETag"))
+	}
+
+	w = get("/missing", "*")
+	if w.Code != http.StatusNotFound || w.Header().Get("ETag") != "" || w.Body.String() != `{"error":"Movie not found"}` {
+		t.Errorf("expected errors to pass through untouched, got %d %q", w.Code, w.Body.String())
+	}
+}
+
+func TestConditionalGET_Movie(t *testing.T) {
+	updated := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	movieID := uuid.Must(uuid.NewV7())
+	mockSvc := &MockMovieService{
+		GetMovieByIdFunc: func(ctx context.Context, id, lang string, appendtoresponse []string, opts model.AppendOptions) (model.MovieResponse, error) {
+			return model.MovieResponse{ID: movieID, Title: "Inception", UpdatedAt: updated}, nil
+		},
+	}
+	var views int
+	trending := &MockTrendingService{RecordViewFunc: func(string) { views++ }}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/movie/", RecordView(trending), ConditionalGET(0, true), New_Movie_Handler(mockSvc).GetMovies)
+
+	req, _ := http.NewRequest("GET", "/movie/?id="+movieID.String(), nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Header().Get("Last-Modified") != "Wed, 04 Mar 2026 05:06:07 GMT" {
+		t.Errorf("expected updated_at as Last-Modified, got %q", w.Header().Get("Last-Modified"))
+	}
+	if cc := w.Header().Get("Cache-Control"); cc != "private, no-cache" {
+		t.Errorf("unexpected Cache-Control %q", cc)
+	}
+
+	req.Header.Set("If-None-Match", w.Header().Get("ETag"))
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusNotModified {
+		t.Errorf("expected 304, got %d", w.Code)
+	}
+	if views != 2 {
+		t.Errorf("expected both the 200 and the 304 counted as views, got %d", views)
+	}
+}
@@ -0,0 +1,31 @@
+package httptransport
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/h-raju-arch/movie_app_backend/internal/service"
+)
+
+type Configuration_handler struct {
+	svc service.Configuration_Service
+}
+
+func New_Configuration_Handler(svc service.Configuration_Service) *Configuration_handler {
+	return &Configuration_handler{svc: svc}
+}
+
+func (h Configuration_handler) ListLanguages(c *gin.Context) {
+
+	ctx := c.Request.Context()
+
+	res, err := h.svc.ListLanguages(ctx)
+	if err != nil {
+		fmt.Println("ListLanguages error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
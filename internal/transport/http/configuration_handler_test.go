@@ -0,0 +1,71 @@
+package httptransport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// MockConfigurationService is a manual mock implementation of Configuration_Service
+type MockConfigurationService struct {
+	ListLanguagesFunc func(ctx context.Context) ([]model.Language, error)
+}
+
+func (m *MockConfigurationService) ListLanguages(ctx context.Context) ([]model.Language, error) {
+	if m.ListLanguagesFunc != nil {
+		return m.ListLanguagesFunc(ctx)
+	}
+	return nil, nil
+}
+
+func setupConfigurationRouter(handler *Configuration_handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/configuration/languages", handler.ListLanguages)
+	return r
+}
+
+func TestListLanguages_Success(t *testing.T) {
+	mockSvc := &MockConfigurationService{
+		ListLanguagesFunc: func(ctx context.Context) ([]model.Language, error) {
+			return []model.Language{{ISO6391: "ja", EnglishName: "Japanese", Name: "日本語"}}, nil
+		},
+	}
+	router := setupConfigurationRouter(New_Configuration_Handler(mockSvc))
+
+	req, _ := http.NewRequest("GET", "/configuration/languages", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	var response []map[string]string
+	json.Unmarshal(w.Body.Bytes(), &response)
+	if len(response) != 1 || response[0]["iso_639_1"] != "ja" || response[0]["english_name"] != "Japanese" || response[0]["name"] != "日本語" {
+		t.Errorf("unexpected response %s", w.Body.String())
+	}
+}
+
+func TestListLanguages_ServiceError(t *testing.T) {
+	mockSvc := &MockConfigurationService{
+		ListLanguagesFunc: func(ctx context.Context) ([]model.Language, error) {
+			return nil, errors.New("database error")
+		},
+	}
+	router := setupConfigurationRouter(New_Configuration_Handler(mockSvc))
+
+	req, _ := http.NewRequest("GET", "/configuration/languages", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+}
@@ -0,0 +1,75 @@
+package httptransport
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/h-raju-arch/movie_app_backend/internal/service"
+)
+
+const (
+	// ExportErrorTrailer is the trailer set when an export fails after the
+	// response has started; the body then holds only the movies before it.
+	ExportErrorTrailer = "X-Export-Error"
+
+	ndjsonContentType = "application/x-ndjson"
+)
+
+type Export_handler struct {
+	svc service.Export_Service
+}
+
+func New_Export_Handler(svc service.Export_Service) *Export_handler {
+	return &Export_handler{svc: svc}
+}
+
+func (h Export_handler) ExportMovies(c *gin.Context) {
+
+	ctx := c.Request.Context()
+
+	var since *time.Time
+	if s := c.Query("since"); s != "" {
+		t, err := service.ParseExportSince(s)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		since = &t
+	}
+
+	c.Header("Trailer", ExportErrorTrailer)
+	_, err := h.svc.Export(ctx, ndjsonWriter{c.Writer}, since, 0)
+	if err != nil {
+		fmt.Println("ExportMovies error:", err)
+		if !c.Writer.Written() {
+			c.Writer.Header().Del("Trailer")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.Writer.Header().Set(ExportErrorTrailer, err.Error())
+		return
+	}
+
+	if !c.Writer.Written() {
+		// nothing matched: an empty NDJSON body
+		c.Header("Content-Type", ndjsonContentType)
+		c.Status(http.StatusOK)
+	}
+}
+
+// ndjsonWriter streams export batches to the client as they are written,
+// instead of buffering the whole catalogue.
+type ndjsonWriter struct {
+	w gin.ResponseWriter
+}
+
+func (n ndjsonWriter) Write(p []byte) (int, error) {
+	if !n.w.Written() {
+		n.w.Header().Set("Content-Type", ndjsonContentType)
+	}
+	written, err := n.w.Write(p)
+	n.w.Flush()
+	return written, err
+}
@@ -0,0 +1,131 @@
+package httptransport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MockExportService is a manual mock implementation of Export_Service
+type MockExportService struct {
+	ExportFunc func(ctx context.Context, w io.Writer, since *time.Time, batchSize int) (int, error)
+}
+
+func (m *MockExportService) Export(ctx context.Context, w io.Writer, since *time.Time, batchSize int) (int, error) {
+	if m.ExportFunc != nil {
+		return m.ExportFunc(ctx, w, since, batchSize)
+	}
+	return 0, nil
+}
+
+func setupExportRouter(handler *Export_handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/admin/export", handler.ExportMovies)
+	return r
+}
+
+func TestExportMovies_Streams(t *testing.T) {
+	var gotSince *time.Time
+	mockSvc := &MockExportService{
+		ExportFunc: func(ctx context.Context, w io.Writer, since *time.Time, batchSize int) (int, error) {
+			gotSince = since
+			io.WriteString(w, "{\"id\":\"a\"}\n")
+			io.WriteString(w, "{\"id\":\"b\"}\n")
+			return 2, nil
+		},
+	}
+	router := setupExportRouter(New_Export_Handler(mockSvc))
+
+	req, _ := http.NewRequest("GET", "/admin/export?since=2026-03-01", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Errorf("expected NDJSON content type, got %s", ct)
+	}
+	if w.Body.String() != "{\"id\":\"a\"}\n{\"id\":\"b\"}\n" {
+		t.Errorf("unexpected body %q", w.Body.String())
+	}
+	if !w.Flushed {
+		t.Error("expected batches to be flushed")
+	}
+	if gotSince == nil || !gotSince.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected since to be passed, got %v", gotSince)
+	}
+}
+
+func TestExportMovies_Empty(t *testing.T) {
+	router := setupExportRouter(New_Export_Handler(&MockExportService{}))
+
+	req, _ := http.NewRequest("GET", "/admin/export", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK || w.Body.Len() != 0 {
+		t.Errorf("expected an empty 200, got %d %q", w.Code, w.Body.String())
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Errorf("expected NDJSON content type, got %s", ct)
+	}
+}
+
+func TestExportMovies_InvalidSince(t *testing.T) {
+	router := setupExportRouter(New_Export_Handler(&MockExportService{}))
+
+	req, _ := http.NewRequest("GET", "/admin/export?since=yesterday", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestExportMovies_ErrorBeforeFirstBatch(t *testing.T) {
+	mockSvc := &MockExportService{
+		ExportFunc: func(ctx context.Context, w io.Writer, since *time.Time, batchSize int) (int, error) {
+			return 0, errors.New("database error")
+		},
+	}
+	router := setupExportRouter(New_Export_Handler(mockSvc))
+
+	req, _ := http.NewRequest("GET", "/admin/export", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+}
+
+func TestExportMovies_ErrorMidStream(t *testing.T) {
+	mockSvc := &MockExportService{
+		ExportFunc: func(ctx context.Context, w io.Writer, since *time.Time, batchSize int) (int, error) {
+			io.WriteString(w, "{\"id\":\"a\"}\n")
+			return 1, errors.New("database error")
+		},
+	}
+	router := setupExportRouter(New_Export_Handler(mockSvc))
+
+	req, _ := http.NewRequest("GET", "/admin/export", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	res := w.Result()
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected the started response to stay %d, got %d", http.StatusOK, res.StatusCode)
+	}
+	if res.Trailer.Get(ExportErrorTrailer) == "" {
+		t.Errorf("expected the %s trailer, got %v", ExportErrorTrailer, res.Trailer)
+	}
+}
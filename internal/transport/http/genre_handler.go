@@ -0,0 +1,32 @@
+package httptransport
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/h-raju-arch/movie_app_backend/internal/service"
+)
+
+type Genre_handler struct {
+	svc service.Genre_Service
+}
+
+func New_Genre_Handler(svc service.Genre_Service) *Genre_handler {
+	return &Genre_handler{svc: svc}
+}
+
+func (h Genre_handler) ListMovieGenres(c *gin.Context) {
+
+	ctx := c.Request.Context()
+	lang := c.DefaultQuery("language", "en")
+
+	res, err := h.svc.ListMovieGenres(ctx, lang)
+	if err != nil {
+		fmt.Println("ListMovieGenres error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,74 @@
+package httptransport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// MockGenreService is a manual mock implementation of Genre_Service
+type MockGenreService struct {
+	ListMovieGenresFunc func(ctx context.Context, lang string) (model.GenreListResponse, error)
+}
+
+func (m *MockGenreService) ListMovieGenres(ctx context.Context, lang string) (model.GenreListResponse, error) {
+	if m.ListMovieGenresFunc != nil {
+		return m.ListMovieGenresFunc(ctx, lang)
+	}
+	return model.GenreListResponse{}, nil
+}
+
+func setupGenreRouter(handler *Genre_handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/genre/movie/list", handler.ListMovieGenres)
+	return r
+}
+
+func TestListMovieGenres_Success(t *testing.T) {
+	mockSvc := &MockGenreService{
+		ListMovieGenresFunc: func(ctx context.Context, lang string) (model.GenreListResponse, error) {
+			if lang != "ja" {
+				t.Errorf("expected language 'ja', got %s", lang)
+			}
+			return model.GenreListResponse{Genres: []model.Genre{{Name: "アクション"}}}, nil
+		},
+	}
+	router := setupGenreRouter(New_Genre_Handler(mockSvc))
+
+	req, _ := http.NewRequest("GET", "/genre/movie/list?language=ja", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	var response model.GenreListResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	if len(response.Genres) != 1 {
+		t.Errorf("expected 1 genre, got %d", len(response.Genres))
+	}
+}
+
+func TestListMovieGenres_ServiceError(t *testing.T) {
+	mockSvc := &MockGenreService{
+		ListMovieGenresFunc: func(ctx context.Context, lang string) (model.GenreListResponse, error) {
+			return model.GenreListResponse{}, errors.New("database error")
+		},
+	}
+	router := setupGenreRouter(New_Genre_Handler(mockSvc))
+
+	req, _ := http.NewRequest("GET", "/genre/movie/list", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+}
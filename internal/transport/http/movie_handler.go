@@ -2,6 +2,7 @@ package httptransport
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -10,6 +11,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid/v5"
 	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/h-raju-arch/movie_app_backend/internal/pagination"
 	"github.com/h-raju-arch/movie_app_backend/internal/service"
 )
 
@@ -38,7 +40,83 @@ func (h Movie_handler) GetMovies(c *gin.Context) {
 	}
 
 	lang := c.DefaultQuery("lang", "en")
-	appendtoresponse := c.Query("append_to_response")
+	appends := splitAppends(c.Query("append_to_response"))
+
+	opts := model.AppendOptions{
+		ImageLanguages: parseLanguageFilter(c.Query("include_image_language")),
+		VideoLanguages: parseLanguageFilter(c.Query("include_video_language")),
+		IncludeAdult:   c.Query("include_adult") == "true",
+	}
+
+	res, err := h.svc.GetMovieById(ctx, id, lang, appends, opts)
+
+	if err != nil {
+		fmt.Println("GetMovies error:", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	setLastModified(c, res.UpdatedAt)
+	c.JSON(http.StatusOK, res)
+}
+
+func (h Movie_handler) GetMovieTranslations(c *gin.Context) {
+
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	if _, err := uuid.FromString(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid movie ID"})
+		return
+	}
+
+	res, err := h.svc.GetTranslations(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Movie not found"})
+		return
+	}
+	if err != nil {
+		fmt.Println("GetMovieTranslations error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h Movie_handler) GetSimilarMovies(c *gin.Context) {
+
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	if _, err := uuid.FromString(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid movie ID"})
+		return
+	}
+
+	lang := c.DefaultQuery("language", "en")
+	includeAdult := c.DefaultQuery("include_adult", "false") == "true"
+	page, pageSize, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	res, err := h.svc.GetSimilar(ctx, id, lang, includeAdult, page, pageSize)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Movie not found"})
+		return
+	}
+	if err != nil {
+		fmt.Println("GetSimilarMovies error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// splitAppends parses a comma separated append_to_response value.
+func splitAppends(appendtoresponse string) []string {
 	var appends []string
 	if appendtoresponse != "" {
 		for _, s := range strings.Split(appendtoresponse, ",") {
@@ -49,16 +127,24 @@ func (h Movie_handler) GetMovies(c *gin.Context) {
 			appends = append(appends, s)
 		}
 	}
+	return appends
+}
 
-	res, err := h.svc.GetMovieById(ctx, id, lang, appends)
-
-	if err != nil {
-		fmt.Println("GetMovies error:", err)
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
+// parseLanguageFilter parses an include_*_language value such as "en,null";
+// "null" selects entries without a language.
+func parseLanguageFilter(value string) model.LanguageFilter {
+	var f model.LanguageFilter
+	for _, s := range strings.Split(value, ",") {
+		s = strings.TrimSpace(s)
+		switch s {
+		case "":
+		case "null":
+			f.IncludeNull = true
+		default:
+			f.Languages = append(f.Languages, s)
+		}
 	}
-
-	c.JSON(http.StatusOK, res)
+	return f
 }
 
 // /------------------------------------------------///
@@ -117,7 +203,23 @@ func (h *Movie_handler) SearchMovieHandler(c *gin.Context) {
 		}
 	}
 
-	resp, err := h.svc.SearchMovie(ctx, q, language, includeAdult, primaryYear, regionNull, page, pageSize)
+	fuzzyStr := c.DefaultQuery("fuzzy", "false")
+	fuzzy := fuzzyStr == "true" || fuzzyStr == "1"
+
+	cursor := c.Query("cursor")
+	if cursor != "" {
+		if _, err := pagination.Decode(cursor); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid cursor"})
+			return
+		}
+	}
+
+	resp, err := h.svc.SearchMovie(ctx, q, language, includeAdult, primaryYear, regionNull, page, pageSize, fuzzy, cursor)
+	if errors.Is(err, pagination.ErrInvalidCursor) {
+		// issued for another query or filters
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid cursor"})
+		return
+	}
 	if err != nil {
 		fmt.Println("Error Search Movie handler:", err)
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -182,7 +284,21 @@ func (h *Movie_handler) DiscoverMovieHandler(c *gin.Context) {
 		params.PageSize = 100
 	}
 
+	if cursor := c.Query("cursor"); cursor != "" {
+		field, dir := params.SortKey()
+		if _, err := pagination.DecodeFor(cursor, field+"."+dir); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid cursor"})
+			return
+		}
+		params.Cursor = cursor
+	}
+
 	res, err := h.svc.Discover(ctx, params)
+	if errors.Is(err, pagination.ErrInvalidCursor) {
+		// issued for other filters
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid cursor"})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusBadGateway, gin.H{
 			"message": err,
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -12,25 +13,28 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid/v5"
 	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/h-raju-arch/movie_app_backend/internal/pagination"
 )
 
 // MockMovieService is a manual mock implementation of Movie_Service
 type MockMovieService struct {
-	GetMovieByIdFunc func(ctx context.Context, id, lang string, appendtoresponse []string) (model.MovieResponse, error)
-	SearchMovieFunc  func(ctx context.Context, searchQuery string, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int) (model.SearchResponse, error)
-	DiscoverFunc     func(ctx context.Context, params model.DiscoverMoviesParams) (model.DiscoverMoviesResponse, error)
+	GetMovieByIdFunc    func(ctx context.Context, id, lang string, appendtoresponse []string, opts model.AppendOptions) (model.MovieResponse, error)
+	SearchMovieFunc     func(ctx context.Context, searchQuery string, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int, fuzzy bool, cursor string) (model.SearchResponse, error)
+	DiscoverFunc        func(ctx context.Context, params model.DiscoverMoviesParams) (model.DiscoverMoviesResponse, error)
+	GetTranslationsFunc func(ctx context.Context, id string) (model.TranslationsResponse, error)
+	GetSimilarFunc      func(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) (model.DiscoverMoviesResponse, error)
 }
 
-func (m *MockMovieService) GetMovieById(ctx context.Context, id, lang string, appendtoresponse []string) (model.MovieResponse, error) {
+func (m *MockMovieService) GetMovieById(ctx context.Context, id, lang string, appendtoresponse []string, opts model.AppendOptions) (model.MovieResponse, error) {
 	if m.GetMovieByIdFunc != nil {
-		return m.GetMovieByIdFunc(ctx, id, lang, appendtoresponse)
+		return m.GetMovieByIdFunc(ctx, id, lang, appendtoresponse, opts)
 	}
 	return model.MovieResponse{}, nil
 }
 
-func (m *MockMovieService) SearchMovie(ctx context.Context, searchQuery string, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int) (model.SearchResponse, error) {
+func (m *MockMovieService) SearchMovie(ctx context.Context, searchQuery string, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int, fuzzy bool, cursor string) (model.SearchResponse, error) {
 	if m.SearchMovieFunc != nil {
-		return m.SearchMovieFunc(ctx, searchQuery, language, includeAdult, primaryYear, region, page, pageSize)
+		return m.SearchMovieFunc(ctx, searchQuery, language, includeAdult, primaryYear, region, page, pageSize, fuzzy, cursor)
 	}
 	return model.SearchResponse{}, nil
 }
@@ -42,12 +46,28 @@ func (m *MockMovieService) Discover(ctx context.Context, params model.DiscoverMo
 	return model.DiscoverMoviesResponse{}, nil
 }
 
+func (m *MockMovieService) GetTranslations(ctx context.Context, id string) (model.TranslationsResponse, error) {
+	if m.GetTranslationsFunc != nil {
+		return m.GetTranslationsFunc(ctx, id)
+	}
+	return model.TranslationsResponse{}, nil
+}
+
+func (m *MockMovieService) GetSimilar(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) (model.DiscoverMoviesResponse, error) {
+	if m.GetSimilarFunc != nil {
+		return m.GetSimilarFunc(ctx, id, lang, includeAdult, page, pageSize)
+	}
+	return model.DiscoverMoviesResponse{}, nil
+}
+
 func setupTestRouter(handler *Movie_handler) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
 	r.GET("/movies", handler.GetMovies)
 	r.GET("/search", handler.SearchMovieHandler)
 	r.GET("/discover", handler.DiscoverMovieHandler)
+	r.GET("/movie/:id/translations", handler.GetMovieTranslations)
+	r.GET("/movie/:id/similar", handler.GetSimilarMovies)
 	return r
 }
 
@@ -94,7 +114,7 @@ func TestGetMovies_Success(t *testing.T) {
 	}
 
 	mockSvc := &MockMovieService{
-		GetMovieByIdFunc: func(ctx context.Context, id, lang string, appendtoresponse []string) (model.MovieResponse, error) {
+		GetMovieByIdFunc: func(ctx context.Context, id, lang string, appendtoresponse []string, opts model.AppendOptions) (model.MovieResponse, error) {
 			if id != movieID.String() {
 				t.Errorf("expected id %s, got %s", movieID.String(), id)
 			}
@@ -128,7 +148,7 @@ func TestGetMovies_WithLanguage(t *testing.T) {
 	}
 
 	mockSvc := &MockMovieService{
-		GetMovieByIdFunc: func(ctx context.Context, id, lang string, appendtoresponse []string) (model.MovieResponse, error) {
+		GetMovieByIdFunc: func(ctx context.Context, id, lang string, appendtoresponse []string, opts model.AppendOptions) (model.MovieResponse, error) {
 			if lang != "ja" {
 				t.Errorf("expected lang 'ja', got %s", lang)
 			}
@@ -153,11 +173,11 @@ func TestGetMovies_WithAppendToResponse(t *testing.T) {
 	expectedMovie := model.MovieResponse{
 		ID:     movieID,
 		Title:  "Inception",
-		Genres: []string{"Sci-Fi", "Action"},
+		Genres: []model.Genre{{Name: "Sci-Fi"}, {Name: "Action"}},
 	}
 
 	mockSvc := &MockMovieService{
-		GetMovieByIdFunc: func(ctx context.Context, id, lang string, appendtoresponse []string) (model.MovieResponse, error) {
+		GetMovieByIdFunc: func(ctx context.Context, id, lang string, appendtoresponse []string, opts model.AppendOptions) (model.MovieResponse, error) {
 			if len(appendtoresponse) != 2 {
 				t.Errorf("expected 2 append items, got %d", len(appendtoresponse))
 			}
@@ -177,11 +197,35 @@ func TestGetMovies_WithAppendToResponse(t *testing.T) {
 	}
 }
 
+func TestGetMovies_ImageLanguageFilter(t *testing.T) {
+	movieID := uuid.Must(uuid.NewV4())
+	mockSvc := &MockMovieService{
+		GetMovieByIdFunc: func(ctx context.Context, id, lang string, appendtoresponse []string, opts model.AppendOptions) (model.MovieResponse, error) {
+			f := opts.ImageLanguages
+			if !f.IncludeNull || len(f.Languages) != 2 || f.Languages[0] != "en" || f.Languages[1] != "ja" {
+				t.Errorf("expected en, ja and null, got %+v", f)
+			}
+			return model.MovieResponse{ID: movieID}, nil
+		},
+	}
+
+	handler := New_Movie_Handler(mockSvc)
+	router := setupTestRouter(handler)
+
+	req, _ := http.NewRequest("GET", "/movies?id="+movieID.String()+"&append_to_response=images&include_image_language=en,null,ja", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
 func TestGetMovies_ServiceError(t *testing.T) {
 	movieID := uuid.Must(uuid.NewV4())
 
 	mockSvc := &MockMovieService{
-		GetMovieByIdFunc: func(ctx context.Context, id, lang string, appendtoresponse []string) (model.MovieResponse, error) {
+		GetMovieByIdFunc: func(ctx context.Context, id, lang string, appendtoresponse []string, opts model.AppendOptions) (model.MovieResponse, error) {
 			return model.MovieResponse{}, errors.New("movie not found")
 		},
 	}
@@ -245,7 +289,7 @@ func TestSearchMovie_Success(t *testing.T) {
 	}
 
 	mockSvc := &MockMovieService{
-		SearchMovieFunc: func(ctx context.Context, searchQuery string, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int) (model.SearchResponse, error) {
+		SearchMovieFunc: func(ctx context.Context, searchQuery string, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int, fuzzy bool, cursor string) (model.SearchResponse, error) {
 			if searchQuery != "inception" {
 				t.Errorf("expected query 'inception', got %s", searchQuery)
 			}
@@ -273,7 +317,7 @@ func TestSearchMovie_Success(t *testing.T) {
 
 func TestSearchMovie_WithPagination(t *testing.T) {
 	mockSvc := &MockMovieService{
-		SearchMovieFunc: func(ctx context.Context, searchQuery string, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int) (model.SearchResponse, error) {
+		SearchMovieFunc: func(ctx context.Context, searchQuery string, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int, fuzzy bool, cursor string) (model.SearchResponse, error) {
 			if page != 2 {
 				t.Errorf("expected page 2, got %d", page)
 			}
@@ -324,9 +368,23 @@ func TestSearchMovie_InvalidPageSize(t *testing.T) {
 	}
 }
 
+func TestSearchMovie_InvalidCursor(t *testing.T) {
+	mockSvc := &MockMovieService{}
+	handler := New_Movie_Handler(mockSvc)
+	router := setupTestRouter(handler)
+
+	req, _ := http.NewRequest("GET", "/search?query=test&cursor=not-a-cursor", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
 func TestSearchMovie_WithAdultContent(t *testing.T) {
 	mockSvc := &MockMovieService{
-		SearchMovieFunc: func(ctx context.Context, searchQuery string, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int) (model.SearchResponse, error) {
+		SearchMovieFunc: func(ctx context.Context, searchQuery string, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int, fuzzy bool, cursor string) (model.SearchResponse, error) {
 			if !includeAdult {
 				t.Error("expected includeAdult to be true")
 			}
@@ -346,6 +404,28 @@ func TestSearchMovie_WithAdultContent(t *testing.T) {
 	}
 }
 
+func TestSearchMovie_Fuzzy(t *testing.T) {
+	mockSvc := &MockMovieService{
+		SearchMovieFunc: func(ctx context.Context, searchQuery string, language string, includeAdult bool, primaryYear sql.NullInt64, region sql.NullString, page, pageSize int, fuzzy bool, cursor string) (model.SearchResponse, error) {
+			if !fuzzy {
+				t.Error("expected fuzzy to be true")
+			}
+			return model.SearchResponse{}, nil
+		},
+	}
+
+	handler := New_Movie_Handler(mockSvc)
+	router := setupTestRouter(handler)
+
+	req, _ := http.NewRequest("GET", "/search?query=godfater&fuzzy=true", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
 // DiscoverMovieHandler tests
 func TestDiscoverMovie_Success(t *testing.T) {
 	movieID := uuid.Must(uuid.NewV4())
@@ -534,3 +614,144 @@ func TestDiscoverMovie_SortBy(t *testing.T) {
 		t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
 	}
 }
+
+func TestDiscoverMovie_Cursor(t *testing.T) {
+	cursor := pagination.Encode(pagination.Cursor{Sort: "vote_average.desc", ID: "0195f6f0-0000-7000-8000-000000000000"})
+	mockSvc := &MockMovieService{
+		DiscoverFunc: func(ctx context.Context, params model.DiscoverMoviesParams) (model.DiscoverMoviesResponse, error) {
+			if params.Cursor != cursor {
+				t.Errorf("expected cursor to be passed through, got %q", params.Cursor)
+			}
+			return model.DiscoverMoviesResponse{}, nil
+		},
+	}
+
+	handler := New_Movie_Handler(mockSvc)
+	router := setupTestRouter(handler)
+
+	req, _ := http.NewRequest("GET", "/discover?sort_by=vote_average.DESC&cursor="+cursor, nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	// a cursor issued for another sort order is rejected
+	req, _ = http.NewRequest("GET", "/discover?sort_by=popularity.desc&cursor="+cursor, nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestDiscoverMovie_CursorOtherFilters(t *testing.T) {
+	mockSvc := &MockMovieService{
+		DiscoverFunc: func(ctx context.Context, params model.DiscoverMoviesParams) (model.DiscoverMoviesResponse, error) {
+			return model.DiscoverMoviesResponse{}, fmt.Errorf("service: DiscoverMovie: %w", pagination.ErrInvalidCursor)
+		},
+	}
+
+	handler := New_Movie_Handler(mockSvc)
+	router := setupTestRouter(handler)
+
+	cursor := pagination.Encode(pagination.Cursor{Sort: "popularity.desc", ID: "0195f6f0-0000-7000-8000-000000000000"})
+	req, _ := http.NewRequest("GET", "/discover?VoteAvgGTE=8&cursor="+cursor, nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+// GetMovieTranslations handler tests
+func TestGetMovieTranslations_Success(t *testing.T) {
+	movieID := uuid.Must(uuid.NewV4())
+	title := "Le Film"
+	mockSvc := &MockMovieService{
+		GetTranslationsFunc: func(ctx context.Context, id string) (model.TranslationsResponse, error) {
+			if id != movieID.String() {
+				t.Errorf("expected id %s, got %s", movieID, id)
+			}
+			return model.TranslationsResponse{ID: movieID, Translations: []model.MovieTranslation{
+				{ISO6391: "fr", Data: model.TranslationData{Title: &title}},
+			}}, nil
+		},
+	}
+	router := setupTestRouter(New_Movie_Handler(mockSvc))
+
+	req, _ := http.NewRequest("GET", "/movie/"+movieID.String()+"/translations", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	var response model.TranslationsResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	if len(response.Translations) != 1 || *response.Translations[0].Data.Title != title {
+		t.Errorf("unexpected response %s", w.Body.String())
+	}
+}
+
+func TestGetMovieTranslations_InvalidID(t *testing.T) {
+	router := setupTestRouter(New_Movie_Handler(&MockMovieService{}))
+
+	req, _ := http.NewRequest("GET", "/movie/not-a-uuid/translations", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestGetMovieTranslations_NotFound(t *testing.T) {
+	mockSvc := &MockMovieService{
+		GetTranslationsFunc: func(ctx context.Context, id string) (model.TranslationsResponse, error) {
+			return model.TranslationsResponse{}, fmt.Errorf("service: Get base movie: %w", sql.ErrNoRows)
+		},
+	}
+	router := setupTestRouter(New_Movie_Handler(mockSvc))
+
+	req, _ := http.NewRequest("GET", "/movie/"+uuid.Must(uuid.NewV4()).String()+"/translations", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestGetSimilarMovies(t *testing.T) {
+	movieID := uuid.Must(uuid.NewV7()).String()
+	mockSvc := &MockMovieService{
+		GetSimilarFunc: func(ctx context.Context, id, lang string, includeAdult bool, page, pageSize int) (model.DiscoverMoviesResponse, error) {
+			if id != movieID {
+				return model.DiscoverMoviesResponse{}, fmt.Errorf("service: Get base movie: %w", sql.ErrNoRows)
+			}
+			if lang != "fr" || !includeAdult || page != 2 || pageSize != 10 {
+				t.Errorf("unexpected call %s %v %d %d", lang, includeAdult, page, pageSize)
+			}
+			return model.DiscoverMoviesResponse{Page: page}, nil
+		},
+	}
+	router := setupTestRouter(New_Movie_Handler(mockSvc))
+
+	for path, want := range map[string]int{
+		"/movie/" + movieID + "/similar?language=fr&include_adult=true&page=2&page_size=10": http.StatusOK,
+		"/movie/" + uuid.Must(uuid.NewV7()).String() + "/similar":                           http.StatusNotFound,
+		"/movie/abc/similar": http.StatusBadRequest,
+	} {
+		req, _ := http.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != want {
+			t.Errorf("%s: expected status %d, got %d", path, want, w.Code)
+		}
+	}
+}
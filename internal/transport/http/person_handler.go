@@ -0,0 +1,47 @@
+package httptransport
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/service"
+)
+
+type Person_handler struct {
+	svc service.Person_Service
+}
+
+func New_Person_Handler(svc service.Person_Service) *Person_handler {
+	return &Person_handler{svc: svc}
+}
+
+func (h Person_handler) GetPerson(c *gin.Context) {
+
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	if _, err := uuid.FromString(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid person ID"})
+		return
+	}
+
+	lang := c.DefaultQuery("language", "en")
+	appends := splitAppends(c.Query("append_to_response"))
+
+	res, err := h.svc.GetPersonById(ctx, id, lang, appends)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Person not found"})
+		return
+	}
+	if err != nil {
+		fmt.Println("GetPerson error:", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,102 @@
+package httptransport
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// MockPersonService is a manual mock implementation of Person_Service
+type MockPersonService struct {
+	GetPersonByIdFunc func(ctx context.Context, id, lang string, appendtoresponse []string) (model.PersonResponse, error)
+}
+
+func (m *MockPersonService) GetPersonById(ctx context.Context, id, lang string, appendtoresponse []string) (model.PersonResponse, error) {
+	if m.GetPersonByIdFunc != nil {
+		return m.GetPersonByIdFunc(ctx, id, lang, appendtoresponse)
+	}
+	return model.PersonResponse{}, nil
+}
+
+func setupPersonRouter(handler *Person_handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/person/:id", handler.GetPerson)
+	return r
+}
+
+func TestGetPerson_InvalidID(t *testing.T) {
+	handler := New_Person_Handler(&MockPersonService{})
+	router := setupPersonRouter(handler)
+
+	req, _ := http.NewRequest("GET", "/person/not-a-uuid", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestGetPerson_Success(t *testing.T) {
+	personID := uuid.Must(uuid.NewV4())
+	mockSvc := &MockPersonService{
+		GetPersonByIdFunc: func(ctx context.Context, id, lang string, appendtoresponse []string) (model.PersonResponse, error) {
+			if lang != "ja" {
+				t.Errorf("expected language 'ja', got %s", lang)
+			}
+			if len(appendtoresponse) != 1 || appendtoresponse[0] != "movie_credits" {
+				t.Errorf("expected movie_credits append, got %v", appendtoresponse)
+			}
+			return model.PersonResponse{
+				ID:           personID,
+				Name:         "Hans Zimmer",
+				MovieCredits: &model.PersonMovieCredits{Cast: []model.PersonMovieCredit{}, Crew: []model.PersonMovieCredit{{Title: "Dune"}}},
+			}, nil
+		},
+	}
+
+	handler := New_Person_Handler(mockSvc)
+	router := setupPersonRouter(handler)
+
+	req, _ := http.NewRequest("GET", "/person/"+personID.String()+"?language=ja&append_to_response=movie_credits", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response model.PersonResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	if response.MovieCredits == nil || len(response.MovieCredits.Crew) != 1 {
+		t.Errorf("expected movie credits in response, got %s", w.Body.String())
+	}
+}
+
+func TestGetPerson_NotFound(t *testing.T) {
+	mockSvc := &MockPersonService{
+		GetPersonByIdFunc: func(ctx context.Context, id, lang string, appendtoresponse []string) (model.PersonResponse, error) {
+			return model.PersonResponse{}, fmt.Errorf("service: Get person: %w", sql.ErrNoRows)
+		},
+	}
+
+	handler := New_Person_Handler(mockSvc)
+	router := setupPersonRouter(handler)
+
+	req, _ := http.NewRequest("GET", "/person/"+uuid.Must(uuid.NewV4()).String(), nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
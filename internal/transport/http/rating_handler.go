@@ -0,0 +1,101 @@
+package httptransport
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/h-raju-arch/movie_app_backend/internal/service"
+)
+
+type Rating_handler struct {
+	svc service.Rating_Service
+}
+
+func New_Rating_Handler(svc service.Rating_Service) *Rating_handler {
+	return &Rating_handler{svc: svc}
+}
+
+func (h Rating_handler) RateMovie(c *gin.Context) {
+
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	userID, _ := UserID(c)
+
+	if _, err := uuid.FromString(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid movie ID"})
+		return
+	}
+
+	var w model.RatingWrite
+	if err := c.ShouldBindJSON(&w); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid JSON body: " + err.Error()})
+		return
+	}
+
+	res, created, err := h.svc.RateMovie(ctx, userID, id, w)
+	var verr *model.ValidationError
+	switch {
+	case err == nil && created:
+		c.JSON(http.StatusCreated, res)
+	case err == nil:
+		c.JSON(http.StatusOK, res)
+	case errors.As(err, &verr):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": "validation failed", "errors": verr.Errors})
+	case errors.Is(err, sql.ErrNoRows):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Movie not found"})
+	default:
+		fmt.Println("RateMovie error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+func (h Rating_handler) DeleteRating(c *gin.Context) {
+
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	userID, _ := UserID(c)
+
+	if _, err := uuid.FromString(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid movie ID"})
+		return
+	}
+
+	res, err := h.svc.DeleteRating(ctx, userID, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Rating not found"})
+		return
+	}
+	if err != nil {
+		fmt.Println("DeleteRating error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h Rating_handler) GetRatedMovies(c *gin.Context) {
+
+	ctx := c.Request.Context()
+	userID, _ := UserID(c)
+
+	lang := c.DefaultQuery("language", "en")
+	page, pageSize, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	res, err := h.svc.GetRatedMovies(ctx, userID, lang, page, pageSize)
+	if err != nil {
+		fmt.Println("GetRatedMovies error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,162 @@
+package httptransport
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// MockRatingService is a manual mock implementation of Rating_Service
+type MockRatingService struct {
+	RateMovieFunc      func(ctx context.Context, userID uuid.UUID, movieID string, w model.RatingWrite) (model.MovieRating, bool, error)
+	DeleteRatingFunc   func(ctx context.Context, userID uuid.UUID, movieID string) (model.MovieRating, error)
+	GetRatedMoviesFunc func(ctx context.Context, userID uuid.UUID, lang string, page, pageSize int) (model.RatedMoviesResponse, error)
+}
+
+func (m *MockRatingService) RateMovie(ctx context.Context, userID uuid.UUID, movieID string, w model.RatingWrite) (model.MovieRating, bool, error) {
+	if m.RateMovieFunc != nil {
+		return m.RateMovieFunc(ctx, userID, movieID, w)
+	}
+	return model.MovieRating{}, false, nil
+}
+
+func (m *MockRatingService) DeleteRating(ctx context.Context, userID uuid.UUID, movieID string) (model.MovieRating, error) {
+	if m.DeleteRatingFunc != nil {
+		return m.DeleteRatingFunc(ctx, userID, movieID)
+	}
+	return model.MovieRating{}, nil
+}
+
+func (m *MockRatingService) GetRatedMovies(ctx context.Context, userID uuid.UUID, lang string, page, pageSize int) (model.RatedMoviesResponse, error) {
+	if m.GetRatedMoviesFunc != nil {
+		return m.GetRatedMoviesFunc(ctx, userID, lang, page, pageSize)
+	}
+	return model.RatedMoviesResponse{}, nil
+}
+
+const testUserHeader = "X-User-Id"
+
+func setupRatingRouter(handler *Rating_handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	identity := TrustedUserHeader(testUserHeader)
+	r.POST("/movie/:id/rating", identity, handler.RateMovie)
+	r.DELETE("/movie/:id/rating", identity, handler.DeleteRating)
+	r.GET("/account/rated/movies", identity, handler.GetRatedMovies)
+	return r
+}
+
+func TestRateMovie_Handler(t *testing.T) {
+	userID := uuid.Must(uuid.NewV7())
+	movieID := uuid.Must(uuid.NewV7()).String()
+
+	tests := []struct {
+		name    string
+		id      string
+		body    string
+		created bool
+		err     error
+		want    int
+	}{
+		{"created", movieID, `{"value": 8.5}`, true, nil, http.StatusCreated},
+		{"updated", movieID, `{"value": 8.5}`, false, nil, http.StatusOK},
+		{"invalid value", movieID, `{"value": 8.3}`, false, &model.ValidationError{Errors: []model.FieldError{{Field: "value", Message: "bad"}}}, http.StatusUnprocessableEntity},
+		{"unknown movie", movieID, `{"value": 8.5}`, false, fmt.Errorf("service: RateMovie: %w", sql.ErrNoRows), http.StatusNotFound},
+		{"invalid id", "abc", `{"value": 8.5}`, false, nil, http.StatusBadRequest},
+		{"invalid body", movieID, `{"value": "high"}`, false, nil, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := &MockRatingService{
+				RateMovieFunc: func(ctx context.Context, gotUser uuid.UUID, gotMovie string, w model.RatingWrite) (model.MovieRating, bool, error) {
+					if gotUser != userID || gotMovie != movieID || w.Value != 8.5 && tt.err == nil {
+						t.Errorf("unexpected call for %s on %s with %v", gotUser, gotMovie, w)
+					}
+					return model.MovieRating{Rating: &w.Value}, tt.created, tt.err
+				},
+			}
+			router := setupRatingRouter(New_Rating_Handler(mockSvc))
+
+			req, _ := http.NewRequest("POST", "/movie/"+tt.id+"/rating", strings.NewReader(tt.body))
+			req.Header.Set(testUserHeader, userID.String())
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestRatingRoutes_RequireUser(t *testing.T) {
+	router := setupRatingRouter(New_Rating_Handler(&MockRatingService{}))
+
+	for _, header := range []string{"", "not-a-uuid"} {
+		req, _ := http.NewRequest("DELETE", "/movie/"+uuid.Must(uuid.NewV7()).String()+"/rating", nil)
+		req.Header.Set(testUserHeader, header)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("%q: expected status %d, got %d", header, http.StatusUnauthorized, w.Code)
+		}
+	}
+}
+
+func TestDeleteRating_NotFound(t *testing.T) {
+	mockSvc := &MockRatingService{
+		DeleteRatingFunc: func(ctx context.Context, userID uuid.UUID, movieID string) (model.MovieRating, error) {
+			return model.MovieRating{}, fmt.Errorf("service: DeleteRating: %w", sql.ErrNoRows)
+		},
+	}
+	router := setupRatingRouter(New_Rating_Handler(mockSvc))
+
+	req, _ := http.NewRequest("DELETE", "/movie/"+uuid.Must(uuid.NewV7()).String()+"/rating", nil)
+	req.Header.Set(testUserHeader, uuid.Must(uuid.NewV7()).String())
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestGetRatedMovies_Handler(t *testing.T) {
+	userID := uuid.Must(uuid.NewV7())
+	mockSvc := &MockRatingService{
+		GetRatedMoviesFunc: func(ctx context.Context, gotUser uuid.UUID, lang string, page, pageSize int) (model.RatedMoviesResponse, error) {
+			if gotUser != userID || lang != "es" || page != 2 || pageSize != 5 {
+				t.Errorf("unexpected call %s %s %d %d", gotUser, lang, page, pageSize)
+			}
+			return model.RatedMoviesResponse{Page: page, Results: []model.RatedMovie{{DiscoverItem: model.DiscoverItem{Title: "Inception"}, Rating: 9}}}, nil
+		},
+	}
+	router := setupRatingRouter(New_Rating_Handler(mockSvc))
+
+	req, _ := http.NewRequest("GET", "/account/rated/movies?language=es&page=2&page_size=5", nil)
+	req.Header.Set(testUserHeader, userID.String())
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	var response struct {
+		Results []map[string]any `json:"results"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &response)
+	if len(response.Results) != 1 || response.Results[0]["title"] != "Inception" || response.Results[0]["rating"] != 9.0 {
+		t.Errorf("unexpected response %s", w.Body.String())
+	}
+}
@@ -0,0 +1,141 @@
+package httptransport
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/h-raju-arch/movie_app_backend/internal/service"
+)
+
+type Review_handler struct {
+	svc service.Review_Service
+}
+
+func New_Review_Handler(svc service.Review_Service) *Review_handler {
+	return &Review_handler{svc: svc}
+}
+
+func (h Review_handler) WriteReview(c *gin.Context) {
+
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	userID, _ := UserID(c)
+
+	if _, err := uuid.FromString(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid movie ID"})
+		return
+	}
+
+	var w model.ReviewWrite
+	if err := c.ShouldBindJSON(&w); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid JSON body: " + err.Error()})
+		return
+	}
+
+	res, created, err := h.svc.WriteReview(ctx, userID, id, w)
+	var verr *model.ValidationError
+	switch {
+	case err == nil && created:
+		c.JSON(http.StatusCreated, res)
+	case err == nil:
+		c.JSON(http.StatusOK, res)
+	case errors.As(err, &verr):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": "validation failed", "errors": verr.Errors})
+	case errors.Is(err, sql.ErrNoRows):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Movie not found"})
+	default:
+		fmt.Println("WriteReview error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+func (h Review_handler) GetReviews(c *gin.Context) {
+
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	viewer, _ := UserID(c) // uuid.Nil for anonymous requests
+
+	if _, err := uuid.FromString(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid movie ID"})
+		return
+	}
+	page, pageSize, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	res, err := h.svc.GetReviews(ctx, id, viewer, page, pageSize)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Movie not found"})
+		return
+	}
+	if err != nil {
+		fmt.Println("GetReviews error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ListReviews is the admin moderation queue.
+func (h Review_handler) ListReviews(c *gin.Context) {
+
+	ctx := c.Request.Context()
+
+	status := c.DefaultQuery("status", model.ReviewPending)
+	if !model.ValidReviewStatus(status) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid status (pending, approved or rejected)"})
+		return
+	}
+	page, pageSize, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	res, err := h.svc.ListReviews(ctx, status, page, pageSize)
+	if err != nil {
+		fmt.Println("ListReviews error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h Review_handler) ApproveReview(c *gin.Context) {
+	h.setStatus(c, "ApproveReview", model.ReviewApproved)
+}
+
+func (h Review_handler) RejectReview(c *gin.Context) {
+	h.setStatus(c, "RejectReview", model.ReviewRejected)
+}
+
+func (h Review_handler) setStatus(c *gin.Context, op, status string) {
+
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	if _, err := uuid.FromString(id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid review ID"})
+		return
+	}
+
+	res, err := h.svc.SetReviewStatus(ctx, id, status)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Review not found"})
+		return
+	}
+	if err != nil {
+		fmt.Println(op, "error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
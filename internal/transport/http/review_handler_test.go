@@ -0,0 +1,191 @@
+package httptransport
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid/v5"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// MockReviewService is a manual mock implementation of Review_Service
+type MockReviewService struct {
+	WriteReviewFunc     func(ctx context.Context, authorID uuid.UUID, movieID string, w model.ReviewWrite) (model.Review, bool, error)
+	GetReviewsFunc      func(ctx context.Context, movieID string, viewer uuid.UUID, page, pageSize int) (model.ReviewsResponse, error)
+	ListReviewsFunc     func(ctx context.Context, status string, page, pageSize int) (model.ReviewsResponse, error)
+	SetReviewStatusFunc func(ctx context.Context, id, status string) (model.Review, error)
+}
+
+func (m *MockReviewService) WriteReview(ctx context.Context, authorID uuid.UUID, movieID string, w model.ReviewWrite) (model.Review, bool, error) {
+	if m.WriteReviewFunc != nil {
+		return m.WriteReviewFunc(ctx, authorID, movieID, w)
+	}
+	return model.Review{}, false, nil
+}
+
+func (m *MockReviewService) GetReviews(ctx context.Context, movieID string, viewer uuid.UUID, page, pageSize int) (model.ReviewsResponse, error) {
+	if m.GetReviewsFunc != nil {
+		return m.GetReviewsFunc(ctx, movieID, viewer, page, pageSize)
+	}
+	return model.ReviewsResponse{}, nil
+}
+
+func (m *MockReviewService) ListReviews(ctx context.Context, status string, page, pageSize int) (model.ReviewsResponse, error) {
+	if m.ListReviewsFunc != nil {
+		return m.ListReviewsFunc(ctx, status, page, pageSize)
+	}
+	return model.ReviewsResponse{}, nil
+}
+
+func (m *MockReviewService) SetReviewStatus(ctx context.Context, id, status string) (model.Review, error) {
+	if m.SetReviewStatusFunc != nil {
+		return m.SetReviewStatusFunc(ctx, id, status)
+	}
+	return model.Review{}, nil
+}
+
+func setupReviewRouter(handler *Review_handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/movie/:id/reviews", OptionalTrustedUserHeader(testUserHeader), handler.GetReviews)
+	r.POST("/movie/:id/reviews", TrustedUserHeader(testUserHeader), handler.WriteReview)
+	admin := r.Group("/admin", AdminAuth(testAdminToken))
+	admin.GET("/reviews", handler.ListReviews)
+	admin.POST("/reviews/:id/approve", handler.ApproveReview)
+	admin.POST("/reviews/:id/reject", handler.RejectReview)
+	return r
+}
+
+func TestWriteReview_Handler(t *testing.T) {
+	userID := uuid.Must(uuid.NewV7())
+	movieID := uuid.Must(uuid.NewV7()).String()
+
+	tests := []struct {
+		name    string
+		id      string
+		body    string
+		created bool
+		err     error
+		want    int
+	}{
+		{"created", movieID, `{"rating": 8, "content": "Great"}`, true, nil, http.StatusCreated},
+		{"replaced", movieID, `{"content": "Great"}`, false, nil, http.StatusOK},
+		{"invalid", movieID, `{"content": ""}`, false, &model.ValidationError{Errors: []model.FieldError{{Field: "content", Message: "is required"}}}, http.StatusUnprocessableEntity},
+		{"unknown movie", movieID, `{"content": "Great"}`, false, fmt.Errorf("service: SaveReview: %w", sql.ErrNoRows), http.StatusNotFound},
+		{"invalid id", "abc", `{"content": "Great"}`, false, nil, http.StatusBadRequest},
+		{"invalid body", movieID, `{"content": 5}`, false, nil, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := &MockReviewService{
+				WriteReviewFunc: func(ctx context.Context, authorID uuid.UUID, gotMovie string, w model.ReviewWrite) (model.Review, bool, error) {
+					if authorID != userID || gotMovie != movieID {
+						t.Errorf("unexpected call for %s on %s", authorID, gotMovie)
+					}
+					return model.Review{Content: w.Content, Status: model.ReviewPending}, tt.created, tt.err
+				},
+			}
+			router := setupReviewRouter(New_Review_Handler(mockSvc))
+
+			req, _ := http.NewRequest("POST", "/movie/"+tt.id+"/reviews", strings.NewReader(tt.body))
+			req.Header.Set(testUserHeader, userID.String())
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestGetReviews_Viewer(t *testing.T) {
+	userID := uuid.Must(uuid.NewV7())
+	movieID := uuid.Must(uuid.NewV7()).String()
+	var gotViewer uuid.UUID
+	mockSvc := &MockReviewService{
+		GetReviewsFunc: func(ctx context.Context, id string, viewer uuid.UUID, page, pageSize int) (model.ReviewsResponse, error) {
+			gotViewer = viewer
+			if id != movieID {
+				return model.ReviewsResponse{}, fmt.Errorf("service: Get base movie: %w", sql.ErrNoRows)
+			}
+			return model.ReviewsResponse{Page: page}, nil
+		},
+	}
+	router := setupReviewRouter(New_Review_Handler(mockSvc))
+
+	for _, tt := range []struct {
+		id     string
+		header string
+		want   int
+		viewer uuid.UUID
+	}{
+		{movieID, "", http.StatusOK, uuid.Nil},
+		{movieID, userID.String(), http.StatusOK, userID},
+		{movieID, "not-a-uuid", http.StatusUnauthorized, uuid.Nil},
+		{uuid.Must(uuid.NewV7()).String(), "", http.StatusNotFound, uuid.Nil},
+	} {
+		gotViewer = uuid.Nil
+		req, _ := http.NewRequest("GET", "/movie/"+tt.id+"/reviews", nil)
+		if tt.header != "" {
+			req.Header.Set(testUserHeader, tt.header)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != tt.want || gotViewer != tt.viewer {
+			t.Errorf("%q: expected status %d as %s, got %d as %s", tt.header, tt.want, tt.viewer, w.Code, gotViewer)
+		}
+	}
+}
+
+func TestModerateReviews_Handler(t *testing.T) {
+	reviewID := uuid.Must(uuid.NewV7()).String()
+	mockSvc := &MockReviewService{
+		ListReviewsFunc: func(ctx context.Context, status string, page, pageSize int) (model.ReviewsResponse, error) {
+			if status != model.ReviewPending {
+				t.Errorf("expected the pending queue by default, got %s", status)
+			}
+			return model.ReviewsResponse{}, nil
+		},
+		SetReviewStatusFunc: func(ctx context.Context, id, status string) (model.Review, error) {
+			if id != reviewID {
+				return model.Review{}, fmt.Errorf("service: SetReviewStatus: %w", sql.ErrNoRows)
+			}
+			return model.Review{Status: status}, nil
+		},
+	}
+	router := setupReviewRouter(New_Review_Handler(mockSvc))
+
+	for _, tt := range []struct {
+		method, path string
+		token        string
+		want         int
+	}{
+		{"GET", "/admin/reviews", testAdminToken, http.StatusOK},
+		{"GET", "/admin/reviews?status=deleted", testAdminToken, http.StatusBadRequest},
+		{"GET", "/admin/reviews", "", http.StatusUnauthorized},
+		{"POST", "/admin/reviews/" + reviewID + "/approve", testAdminToken, http.StatusOK},
+		{"POST", "/admin/reviews/" + reviewID + "/reject", testAdminToken, http.StatusOK},
+		{"POST", "/admin/reviews/" + uuid.Must(uuid.NewV7()).String() + "/approve", testAdminToken, http.StatusNotFound},
+		{"POST", "/admin/reviews/abc/reject", testAdminToken, http.StatusBadRequest},
+	} {
+		req, _ := http.NewRequest(tt.method, tt.path, nil)
+		if tt.token != "" {
+			req.Header.Set(AdminTokenHeader, tt.token)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != tt.want {
+			t.Errorf("%s %s: expected status %d, got %d", tt.method, tt.path, tt.want, w.Code)
+		}
+	}
+}
@@ -5,15 +5,111 @@ import (
 	"github.com/h-raju-arch/movie_app_backend/internal/service"
 )
 
-func NewRouter(movie_svc service.Movie_Service) *gin.Engine {
+// Services are the services the API is served from.
+type Services struct {
+	Movie         service.Movie_Service
+	Person        service.Person_Service
+	Company       service.Company_Service
+	Genre         service.Genre_Service
+	Configuration service.Configuration_Service
+	Admin         service.Admin_Service
+	Export        service.Export_Service
+	Auth          service.Auth_Service
+	Rating        service.Rating_Service
+	Account       service.Account_Service
+	Review        service.Review_Service
+	Trending      service.Trending_Service
+	Cache         service.Cache_Stats // nil when responses aren't cached
+}
+
+// Options configures the router.
+type Options struct {
+	AdminToken string // X-Admin-Token expected on /api/admin; empty disables the admin API
+
+	// Identity authenticates the routes that act for a user and stores
+	// their id (see UserID). Nil means UserAuth with the Auth service's
+	// access tokens.
+	Identity gin.HandlerFunc
+
+	// OptionalIdentity identifies the user on public routes that show more
+	// to signed-in users, such as the account_states append or their own
+	// pending reviews. Nil means OptionalUserAuth.
+	OptionalIdentity gin.HandlerFunc
+
+	// MaxAge is the Cache-Control max-age of each route served with ETags
+	// (see ConditionalGET).
+	MaxAge MaxAges
+}
+
+func NewRouter(svc Services, opts Options) *gin.Engine {
 	router := gin.Default()
-	h := New_Movie_Handler(movie_svc)
+	h := New_Movie_Handler(svc.Movie)
+	ph := New_Person_Handler(svc.Person)
+	ch := New_Company_Handler(svc.Company)
+	gh := New_Genre_Handler(svc.Genre)
+	cfg := New_Configuration_Handler(svc.Configuration)
+	ah := New_Admin_Handler(svc.Admin)
+	eh := New_Export_Handler(svc.Export)
+	auh := New_Auth_Handler(svc.Auth)
+	rh := New_Rating_Handler(svc.Rating)
+	ach := New_Account_Handler(svc.Account)
+	rvh := New_Review_Handler(svc.Review)
+	th := New_Trending_Handler(svc.Trending)
+	cah := New_Cache_Handler(svc.Cache)
+
+	identity := opts.Identity
+	if identity == nil {
+		identity = UserAuth(svc.Auth)
+	}
+	optionalIdentity := opts.OptionalIdentity
+	if optionalIdentity == nil {
+		optionalIdentity = OptionalUserAuth(svc.Auth)
+	}
 
 	api := router.Group("/api")
 	{
-		api.GET("/movie/", h.GetMovies)
-		api.GET("/movies/search", h.SearchMovieHandler)
-		api.GET("/movies/discover", h.DiscoverMovieHandler)
+		api.GET("/movie/", optionalIdentity, RecordView(svc.Trending), ConditionalGET(opts.MaxAge.Movie, true), h.GetMovies)
+		api.GET("/movie/:id/translations", h.GetMovieTranslations)
+		api.GET("/movie/:id/similar", h.GetSimilarMovies)
+		api.GET("/movies/search", ConditionalGET(opts.MaxAge.Search, false), h.SearchMovieHandler)
+		api.GET("/movies/discover", ConditionalGET(opts.MaxAge.Discover, false), h.DiscoverMovieHandler)
+		api.GET("/trending/movie/:time_window", th.GetTrendingMovies)
+		api.GET("/person/:id", ph.GetPerson)
+		api.GET("/company/:id", ch.GetCompany)
+		api.GET("/genre/movie/list", gh.ListMovieGenres)
+		api.GET("/configuration/languages", cfg.ListLanguages)
+		api.POST("/auth/register", auh.Register)
+		api.POST("/auth/login", auh.Login)
+		api.POST("/auth/refresh", auh.Refresh)
+		api.POST("/movie/:id/rating", identity, rh.RateMovie)
+		api.DELETE("/movie/:id/rating", identity, rh.DeleteRating)
+		api.GET("/movie/:id/reviews", optionalIdentity, rvh.GetReviews)
+		api.POST("/movie/:id/reviews", identity, rvh.WriteReview)
+	}
+
+	account := api.Group("/account", identity)
+	{
+		account.GET("", auh.GetAccount)
+		account.GET("/rated/movies", rh.GetRatedMovies)
+		account.POST("/watchlist", ach.SetWatchlist)
+		account.GET("/watchlist/movies", ach.GetWatchlist)
+		account.POST("/favorite", ach.SetFavorite)
+		account.GET("/favorite/movies", ach.GetFavorites)
+	}
+
+	admin := api.Group("/admin", AdminAuth(opts.AdminToken))
+	{
+		admin.POST("/movies", ah.CreateMovie)
+		admin.PUT("/movies/:id", ah.ReplaceMovie)
+		admin.PATCH("/movies/:id", ah.PatchMovie)
+		admin.DELETE("/movies/:id", ah.DeleteMovie)
+		admin.PUT("/movies/:id/translations/:lang", ah.PutTranslation)
+		admin.DELETE("/movies/:id/translations/:lang", ah.DeleteTranslation)
+		admin.GET("/export", eh.ExportMovies)
+		admin.GET("/reviews", rvh.ListReviews)
+		admin.POST("/reviews/:id/approve", rvh.ApproveReview)
+		admin.POST("/reviews/:id/reject", rvh.RejectReview)
+		admin.GET("/cache/stats", cah.GetCacheStats)
 	}
 	return router
 }
@@ -0,0 +1,55 @@
+package httptransport
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+	"github.com/h-raju-arch/movie_app_backend/internal/service"
+)
+
+type Trending_handler struct {
+	svc service.Trending_Service
+}
+
+func New_Trending_Handler(svc service.Trending_Service) *Trending_handler {
+	return &Trending_handler{svc: svc}
+}
+
+func (h Trending_handler) GetTrendingMovies(c *gin.Context) {
+
+	ctx := c.Request.Context()
+	window := c.Param("time_window")
+	lang := c.DefaultQuery("language", "en")
+	includeAdult := c.DefaultQuery("include_adult", "false") == "true"
+	page, pageSize, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	res, err := h.svc.GetTrending(ctx, window, lang, includeAdult, page, pageSize)
+	var verr *model.ValidationError
+	switch {
+	case err == nil:
+		c.JSON(http.StatusOK, res)
+	case errors.As(err, &verr):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": "validation failed", "errors": verr.Errors})
+	default:
+		fmt.Println("GetTrendingMovies error:", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// RecordView counts a view of the movie in the id query parameter once the
+// handler has served it, or confirmed the client's copy is current (304),
+// so failed and rejected requests don't count.
+func RecordView(svc service.Trending_Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+		if status := c.Writer.Status(); status == http.StatusOK || status == http.StatusNotModified {
+			svc.RecordView(c.Query("id"))
+		}
+	}
+}
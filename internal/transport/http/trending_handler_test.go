@@ -0,0 +1,90 @@
+package httptransport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/h-raju-arch/movie_app_backend/internal/model"
+)
+
+// MockTrendingService is a manual mock implementation of Trending_Service
+type MockTrendingService struct {
+	RecordViewFunc  func(movieID string)
+	GetTrendingFunc func(ctx context.Context, window, lang string, includeAdult bool, page, pageSize int) (model.DiscoverMoviesResponse, error)
+}
+
+func (m *MockTrendingService) RecordView(movieID string) {
+	if m.RecordViewFunc != nil {
+		m.RecordViewFunc(movieID)
+	}
+}
+
+func (m *MockTrendingService) GetTrending(ctx context.Context, window, lang string, includeAdult bool, page, pageSize int) (model.DiscoverMoviesResponse, error) {
+	if m.GetTrendingFunc != nil {
+		return m.GetTrendingFunc(ctx, window, lang, includeAdult, page, pageSize)
+	}
+	return model.DiscoverMoviesResponse{}, nil
+}
+
+func TestGetTrendingMovies(t *testing.T) {
+	mockSvc := &MockTrendingService{
+		GetTrendingFunc: func(ctx context.Context, window, lang string, includeAdult bool, page, pageSize int) (model.DiscoverMoviesResponse, error) {
+			if window != "day" && window != "week" {
+				return model.DiscoverMoviesResponse{}, &model.ValidationError{Errors: []model.FieldError{{Field: "time_window", Message: "must be day or week"}}}
+			}
+			if window == "week" {
+				return model.DiscoverMoviesResponse{}, errors.New("db down")
+			}
+			if lang != "ja" || !includeAdult || page != 3 || pageSize != 5 {
+				t.Errorf("unexpected call %s %v %d %d", lang, includeAdult, page, pageSize)
+			}
+			return model.DiscoverMoviesResponse{Page: page}, nil
+		},
+	}
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/trending/movie/:time_window", New_Trending_Handler(mockSvc).GetTrendingMovies)
+
+	for path, want := range map[string]int{
+		"/trending/movie/day?language=ja&include_adult=true&page=3&page_size=5": http.StatusOK,
+		"/trending/movie/month":        http.StatusUnprocessableEntity,
+		"/trending/movie/week":         http.StatusInternalServerError,
+		"/trending/movie/day?page=abc": http.StatusBadRequest,
+	} {
+		req, _ := http.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != want {
+			t.Errorf("%s: expected status %d, got %d", path, want, w.Code)
+		}
+	}
+}
+
+func TestRecordView(t *testing.T) {
+	var recorded []string
+	mockSvc := &MockTrendingService{RecordViewFunc: func(movieID string) { recorded = append(recorded, movieID) }}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/movie/", RecordView(mockSvc), func(c *gin.Context) {
+		if c.Query("id") == "missing" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Movie not found"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{})
+	})
+
+	for _, id := range []string{"a", "missing", "b"} {
+		req, _ := http.NewRequest("GET", "/movie/?id="+id, nil)
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	if len(recorded) != 2 || recorded[0] != "a" || recorded[1] != "b" {
+		t.Errorf("expected only served movies to be recorded, got %v", recorded)
+	}
+}